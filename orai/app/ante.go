@@ -5,13 +5,27 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
-	"github.com/cosmos/cosmos-sdk/x/authz"
-	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	ibcante "github.com/cosmos/ibc-go/v4/modules/core/ante"
 	"github.com/cosmos/ibc-go/v4/modules/core/keeper"
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmTypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	"github.com/oraichain/orai/app/relayguard"
+	"github.com/oraichain/orai/app/simgas"
+	"github.com/oraichain/orai/app/walker"
+	commissionkeeper "github.com/oraichain/orai/x/commission/keeper"
+	feeshareante "github.com/oraichain/orai/x/feeshare/ante"
+	feesharekeeper "github.com/oraichain/orai/x/feeshare/keeper"
+	feetokenkeeper "github.com/oraichain/orai/x/feetoken/keeper"
+	globalfeeante "github.com/oraichain/orai/x/globalfee/ante"
+	globalfeekeeper "github.com/oraichain/orai/x/globalfee/keeper"
+	msgfilterante "github.com/oraichain/orai/x/msgfilter/ante"
+	msgfilterkeeper "github.com/oraichain/orai/x/msgfilter/keeper"
+	msglimitante "github.com/oraichain/orai/x/msglimit/ante"
+	msglimitkeeper "github.com/oraichain/orai/x/msglimit/keeper"
+	sponsorante "github.com/oraichain/orai/x/sponsor/ante"
+	sponsorkeeper "github.com/oraichain/orai/x/sponsor/keeper"
 )
 
 // HandlerOptions extends the SDK's AnteHandler options by requiring the IBC
@@ -23,75 +37,39 @@ type HandlerOptions struct {
 	TxCounterStoreKey sdk.StoreKey
 	WasmConfig        wasmTypes.WasmConfig
 	Cdc               codec.BinaryCodec
+	CommissionKeeper  *commissionkeeper.Keeper
+	GlobalFeeKeeper   *globalfeekeeper.Keeper
+	FeeTokenKeeper    *feetokenkeeper.Keeper
+	SponsorKeeper     *sponsorkeeper.Keeper
+	MsgLimitKeeper    *msglimitkeeper.Keeper
+	MsgFilterKeeper   *msgfilterkeeper.Keeper
+	FeeShareKeeper    *feesharekeeper.Keeper
+	// RelayTracker counts failed relay txs across CheckTx calls
+	RelayTracker *relayguard.Tracker
+	// MaxMsgDepth bounds how deep wrapped messages may be nested,
+	// walker.DefaultMaxDepth is used when unset
+	MaxMsgDepth int
 }
 
 type MinCommissionDecorator struct {
-	cdc codec.BinaryCodec
+	walker           walker.Walker
+	commissionKeeper *commissionkeeper.Keeper
 }
 
-func NewMinCommissionDecorator(cdc codec.BinaryCodec) MinCommissionDecorator {
-	return MinCommissionDecorator{cdc}
+func NewMinCommissionDecorator(msgWalker walker.Walker, commissionKeeper *commissionkeeper.Keeper) MinCommissionDecorator {
+	return MinCommissionDecorator{msgWalker, commissionKeeper}
 }
 
 func (min MinCommissionDecorator) AnteHandle(
 	ctx sdk.Context, tx sdk.Tx,
 	simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
-	msgs := tx.GetMsgs()
-	minCommissionRate := sdk.NewDecWithPrec(3, 2)
-
-	validMsg := func(m sdk.Msg) error {
-		switch msg := m.(type) {
-		case *stakingtypes.MsgCreateValidator:
-			// prevent new validators joining the set with
-			// commission set below 3%
-			c := msg.Commission
-			if c.Rate.LT(minCommissionRate) {
-				return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "commission can't be lower than 3%")
-			}
-		case *stakingtypes.MsgEditValidator:
-			// if commission rate is nil, it means only
-			// other fields are affected - skip
-			if msg.CommissionRate == nil {
-				break
-			}
-			if msg.CommissionRate.LT(minCommissionRate) {
-				return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "commission can't be lower than 3%")
-			}
-		}
-
-		return nil
-	}
-
-	validAuthz := func(execMsg *authz.MsgExec) error {
-		for _, v := range execMsg.Msgs {
-			var innerMsg sdk.Msg
-			err := min.cdc.UnpackAny(v, &innerMsg)
-			if err != nil {
-				return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "cannot unmarshal authz exec msgs")
-			}
-
-			err = validMsg(innerMsg)
-			if err != nil {
-				return err
-			}
-		}
-
-		return nil
-	}
-
-	for _, m := range msgs {
-		if msg, ok := m.(*authz.MsgExec); ok {
-			if err := validAuthz(msg); err != nil {
-				return ctx, err
-			}
-			continue
-		}
-
-		// validate normal msgs
-		err = validMsg(m)
-		if err != nil {
-			return ctx, err
-		}
+	// validate normal msgs as well as msgs wrapped inside authz, the msgs of
+	// interchain account packets are validated by the staking msg server
+	err = min.walker.Walk(tx.GetMsgs(), func(msg sdk.Msg, _ int) error {
+		return min.commissionKeeper.ValidateMsg(ctx, msg)
+	})
+	if err != nil {
+		return ctx, err
 	}
 
 	return next(ctx, tx, simulate)
@@ -109,33 +87,93 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "bank keeper is required for ante builder")
 	}
 
+	if options.CommissionKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "commission keeper is required for ante builder")
+	}
+
+	if options.GlobalFeeKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "globalfee keeper is required for ante builder")
+	}
+
+	if options.FeeTokenKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "feetoken keeper is required for ante builder")
+	}
+
+	if options.SponsorKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "sponsor keeper is required for ante builder")
+	}
+
+	if options.MsgLimitKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "msglimit keeper is required for ante builder")
+	}
+
+	if options.MsgFilterKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "msgfilter keeper is required for ante builder")
+	}
+
+	if options.FeeShareKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "feeshare keeper is required for ante builder")
+	}
+
+	if options.RelayTracker == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "relay tracker is required for ante builder")
+	}
+
 	if options.SignModeHandler == nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
 	}
 
+	bankKeeper, ok := options.BankKeeper.(simgas.BankKeeper)
+	if !ok {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "bank keeper must read balances for ante builder")
+	}
+
 	var sigGasConsumer = options.SigGasConsumer
 	if sigGasConsumer == nil {
 		sigGasConsumer = ante.DefaultSigVerificationGasConsumer
 	}
 
+	var maxMsgDepth = options.MaxMsgDepth
+	if maxMsgDepth == 0 {
+		maxMsgDepth = walker.DefaultMaxDepth
+	}
+	// the msgs of interchain account host packets are checked by the msg
+	// service router when the host executes them, so that a failing one gets
+	// an error ack. Rejecting the relay tx instead would leave the packet
+	// without an ack, until the ordered channel times out and closes
+	msgWalker := walker.NewWithUnwrappers(options.Cdc, maxMsgDepth, walker.UnwrapAuthzExec)
+
 	anteDecorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
-		NewMinCommissionDecorator(options.Cdc),
+		// replaces the gas meter in simulation, so it must come before any decorator consuming gas
 		wasmkeeper.NewLimitSimulationGasDecorator(options.WasmConfig.SimulationGasLimit),
-		wasmkeeper.NewCountTXDecorator(options.TxCounterStoreKey),
+		msgfilterante.NewMsgFilterDecorator(msgWalker, options.MsgFilterKeeper),
+		NewMinCommissionDecorator(msgWalker, options.CommissionKeeper),
+		msglimitante.NewMsgLimitDecorator(msgWalker, options.MsgLimitKeeper),
+		simgas.NewCountTXDecorator(options.TxCounterStoreKey),
 		ante.NewRejectExtensionOptionsDecorator(),
-		ante.NewMempoolFeeDecorator(),
+		// replaces ante.NewMempoolFeeDecorator, also checks the local minimum gas prices in CheckTx.
+		// Whitelisted IBC fee tokens count at their converted value
+		globalfeeante.NewFeeDecorator(options.GlobalFeeKeeper, options.FeeTokenKeeper),
 		ante.NewValidateBasicDecorator(),
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
-		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper),
+		// the simgas decorators charge for the signatures and fees missing from simulated txs
+		simgas.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+		simgas.NewDeductFeeGasDecorator(options.AccountKeeper, bankKeeper, options.GlobalFeeKeeper),
+		// falls back to ante.NewDeductFeeDecorator unless a contract sponsors the tx
+		sponsorante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.SponsorKeeper),
+		// pays the developer shares out of the fees deducted above
+		feeshareante.NewDistributeFeeShareDecorator(msgWalker, options.FeeShareKeeper),
 		// SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewSetPubKeyDecorator(options.AccountKeeper),
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
-		ante.NewSigGasConsumeDecorator(options.AccountKeeper, sigGasConsumer),
+		simgas.NewSigGasConsumeDecorator(options.AccountKeeper, sigGasConsumer),
 		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
 		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
+		// only wraps ibcante.NewAnteDecorator, so that the failures it counts are
+		// relay errors of signers whose signatures were verified
+		relayguard.NewRelayGuardDecorator(options.RelayTracker),
 		ibcante.NewAnteDecorator(options.IBCKeeper),
 	}
 
@@ -0,0 +1,132 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/CosmWasm/wasmd/x/wasm"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/oraichain/orai/app/relayguard"
+	feesharetypes "github.com/oraichain/orai/x/feeshare/types"
+	msglimittypes "github.com/oraichain/orai/x/msglimit/types"
+)
+
+// icaHostRecvPacket returns a relay of an interchain account packet
+// executing msgs on this chain
+func icaHostRecvPacket(t *testing.T, gapp *OraichainApp, relayer sdk.AccAddress, msgs ...sdk.Msg) *channeltypes.MsgRecvPacket {
+	data, err := icatypes.SerializeCosmosTx(gapp.appCodec, msgs)
+	require.NoError(t, err)
+	packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: data}
+	packet := channeltypes.NewPacket(packetData.GetBytes(), 1, "icacontroller-owner", "channel-0", icatypes.PortID, "channel-0", clienttypes.NewHeight(0, 100), 0)
+	return channeltypes.NewMsgRecvPacket(packet, []byte("proof"), clienttypes.NewHeight(0, 1), relayer.String())
+}
+
+// newTestAnteHandler returns the ante handler of the app, as NewOraichainApp
+// builds it
+func newTestAnteHandler(t *testing.T, gapp *OraichainApp) sdk.AnteHandler {
+	anteHandler, err := NewAnteHandler(HandlerOptions{
+		HandlerOptions: ante.HandlerOptions{
+			AccountKeeper:   gapp.accountKeeper,
+			BankKeeper:      gapp.bankKeeper,
+			FeegrantKeeper:  gapp.feeGrantKeeper,
+			SignModeHandler: MakeEncodingConfig().TxConfig.SignModeHandler(),
+		},
+		IBCKeeper:         gapp.ibcKeeper,
+		TxCounterStoreKey: gapp.keys[wasm.StoreKey],
+		WasmConfig:        wasmtypes.DefaultWasmConfig(),
+		Cdc:               gapp.appCodec,
+		CommissionKeeper:  &gapp.CommissionKeeper,
+		GlobalFeeKeeper:   &gapp.GlobalFeeKeeper,
+		FeeTokenKeeper:    &gapp.FeeTokenKeeper,
+		SponsorKeeper:     &gapp.SponsorKeeper,
+		MsgLimitKeeper:    &gapp.MsgLimitKeeper,
+		MsgFilterKeeper:   &gapp.MsgFilterKeeper,
+		FeeShareKeeper:    &gapp.FeeShareKeeper,
+		RelayTracker: relayguard.NewTracker(relayguard.DefaultConfig(), gapp.tkeys[relayguard.StoreKey], func() sdk.Context {
+			return gapp.NewContext(true, tmproto.Header{})
+		}),
+	})
+	require.NoError(t, err)
+	return anteHandler
+}
+
+// ensure that the ante checks of the msgs an interchain account packet
+// executes are left to the host, so that a failing msg gets an error ack
+// rather than failing the relay tx
+func TestAnteSkipsICAHostMsgs(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	relayer := simTestAccount{privKeys: []cryptotypes.PrivKey{privKey}, pubKey: privKey.PubKey()}
+	gapp := setupSimTestApp(t, relayer)
+
+	encodingConfig := MakeEncodingConfig()
+	anteHandler := newTestAnteHandler(t, gapp)
+
+	lowRate := sdk.NewDecWithPrec(1, 2)
+	sends := make([]sdk.Msg, msglimittypes.DefaultMaxInnerMsgs+1)
+	for i := range sends {
+		sends[i] = banktypes.NewMsgSend(relayer.address(), relayer.address(), sdk.NewCoins(sdk.NewInt64Coin("orai", 1)))
+	}
+	cases := map[string][]sdk.Msg{
+		"commission below the floor": {
+			stakingtypes.NewMsgEditValidator(sdk.ValAddress(relayer.address()), stakingtypes.Description{}, &lowRate, nil),
+		},
+		"too many inner msgs": sends,
+	}
+	for name, msgs := range cases {
+		t.Run(name, func(t *testing.T) {
+			msg := icaHostRecvPacket(t, gapp, relayer.address(), msgs...)
+			tx, err := encodingConfig.TxConfig.TxDecoder()(buildSimTestTx(t, gapp, relayer, []sdk.Msg{msg}, false))
+			require.NoError(t, err)
+
+			// the packet is on an unknown channel, so the relay only fails in
+			// the ibc ante, after the other checks passed
+			ctx := gapp.NewContext(true, tmproto.Header{ChainID: simTestChainID, Height: gapp.LastBlockHeight()})
+			_, err = anteHandler(ctx, tx, false)
+			require.ErrorIs(t, err, capabilitytypes.ErrCapabilityNotFound)
+		})
+	}
+}
+
+// ensure that the relayer of an interchain account packet executing a
+// contract does not pay the fee share of the contract
+func TestFeeShareSkipsICAHostMsgs(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	relayer := simTestAccount{privKeys: []cryptotypes.PrivKey{privKey}, pubKey: privKey.PubKey()}
+	gapp := setupSimTestApp(t, relayer)
+	anteHandler := newTestAnteHandler(t, gapp)
+
+	contract := sdk.AccAddress("contract____________")
+	withdrawer := sdk.AccAddress("withdrawer__________")
+	ctx := gapp.NewContext(true, tmproto.Header{ChainID: simTestChainID, Height: gapp.LastBlockHeight()})
+	gapp.FeeShareKeeper.SetFeeShare(ctx, feesharetypes.NewFeeShare(contract, relayer.address(), withdrawer))
+
+	execMsg := &wasmtypes.MsgExecuteContract{Sender: relayer.address().String(), Contract: contract.String(), Msg: []byte(`{}`)}
+	msg := icaHostRecvPacket(t, gapp, relayer.address(), execMsg)
+	tx, err := MakeEncodingConfig().TxConfig.TxDecoder()(buildSimTestTx(t, gapp, relayer, []sdk.Msg{msg}, false))
+	require.NoError(t, err)
+
+	// the state changes of the ante handler are kept although the relay
+	// fails in the ibc ante
+	_, err = anteHandler(ctx, tx, false)
+	require.ErrorIs(t, err, capabilitytypes.ErrCapabilityNotFound)
+	require.True(t, gapp.bankKeeper.GetAllBalances(ctx, withdrawer).IsZero())
+
+	// while the same execution at the top level of the tx pays it
+	tx, err = MakeEncodingConfig().TxConfig.TxDecoder()(buildSimTestTx(t, gapp, relayer, []sdk.Msg{execMsg}, false))
+	require.NoError(t, err)
+	_, err = anteHandler(ctx, tx, false)
+	require.NoError(t, err)
+	require.False(t, gapp.bankKeeper.GetAllBalances(ctx, withdrawer).IsZero())
+}
@@ -39,7 +39,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
-	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -113,12 +112,6 @@ import (
 	ibchost "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 	ibckeeper "github.com/cosmos/ibc-go/v4/modules/core/keeper"
 
-	// Note: please do your research before using this in production app, this is a demo and not an officially
-	// supported IBC team implementation. It has no known issues, but do your own research before using it.
-	intertx "github.com/cosmos/interchain-accounts/x/inter-tx"
-	intertxkeeper "github.com/cosmos/interchain-accounts/x/inter-tx/keeper"
-	intertxtypes "github.com/cosmos/interchain-accounts/x/inter-tx/types"
-
 	// unnamed import of statik for swagger UI support
 	_ "github.com/oraichain/orai/doc/statik"
 
@@ -134,9 +127,54 @@ import (
 	appparams "github.com/oraichain/orai/app/params"
 	appconfig "github.com/oraichain/orai/cmd/config"
 
-	"github.com/CosmosContracts/juno/v18/x/clock"
-	clockkeeper "github.com/CosmosContracts/juno/v18/x/clock/keeper"
-	clocktypes "github.com/CosmosContracts/juno/v18/x/clock/types"
+	"github.com/oraichain/orai/app/relayguard"
+	"github.com/oraichain/orai/app/upgrades"
+	"github.com/oraichain/orai/app/walker"
+	"github.com/oraichain/orai/app/wasmbinding"
+	"github.com/oraichain/orai/app/wasmhooks"
+	"github.com/oraichain/orai/x/commission"
+	commissionkeeper "github.com/oraichain/orai/x/commission/keeper"
+	commissiontypes "github.com/oraichain/orai/x/commission/types"
+	"github.com/oraichain/orai/x/feetoken"
+	feetokenkeeper "github.com/oraichain/orai/x/feetoken/keeper"
+	feetokentypes "github.com/oraichain/orai/x/feetoken/types"
+	"github.com/oraichain/orai/x/globalfee"
+	globalfeekeeper "github.com/oraichain/orai/x/globalfee/keeper"
+	globalfeetypes "github.com/oraichain/orai/x/globalfee/types"
+	"github.com/oraichain/orai/x/msgfilter"
+	msgfilterclient "github.com/oraichain/orai/x/msgfilter/client"
+	msgfilterkeeper "github.com/oraichain/orai/x/msgfilter/keeper"
+	msgfiltertypes "github.com/oraichain/orai/x/msgfilter/types"
+
+	v0420 "github.com/oraichain/orai/app/upgrades/v0420"
+	"github.com/oraichain/orai/x/clock"
+	clockkeeper "github.com/oraichain/orai/x/clock/keeper"
+	clocktypes "github.com/oraichain/orai/x/clock/types"
+	"github.com/oraichain/orai/x/drip"
+	dripkeeper "github.com/oraichain/orai/x/drip/keeper"
+	driptypes "github.com/oraichain/orai/x/drip/types"
+	"github.com/oraichain/orai/x/feeshare"
+	feesharekeeper "github.com/oraichain/orai/x/feeshare/keeper"
+	feesharetypes "github.com/oraichain/orai/x/feeshare/types"
+	"github.com/oraichain/orai/x/icaauth"
+	icaauthkeeper "github.com/oraichain/orai/x/icaauth/keeper"
+	icaauthtypes "github.com/oraichain/orai/x/icaauth/types"
+	"github.com/oraichain/orai/x/icq"
+	icqkeeper "github.com/oraichain/orai/x/icq/keeper"
+	icqtypes "github.com/oraichain/orai/x/icq/types"
+	"github.com/oraichain/orai/x/msglimit"
+	msglimitkeeper "github.com/oraichain/orai/x/msglimit/keeper"
+	msglimittypes "github.com/oraichain/orai/x/msglimit/types"
+	"github.com/oraichain/orai/x/ratelimit"
+	ratelimitclient "github.com/oraichain/orai/x/ratelimit/client"
+	ratelimitkeeper "github.com/oraichain/orai/x/ratelimit/keeper"
+	ratelimittypes "github.com/oraichain/orai/x/ratelimit/types"
+	"github.com/oraichain/orai/x/sponsor"
+	sponsorkeeper "github.com/oraichain/orai/x/sponsor/keeper"
+	sponsortypes "github.com/oraichain/orai/x/sponsor/types"
+	"github.com/oraichain/orai/x/tokenfactory"
+	tokenfactorykeeper "github.com/oraichain/orai/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/oraichain/orai/x/tokenfactory/types"
 )
 
 const appName = "Oraichain"
@@ -145,8 +183,6 @@ const appName = "Oraichain"
 var (
 	NodeDir = ".oraid"
 
-	BinaryVersion = "v0.41.7"
-
 	// If EnabledSpecificProposals is "", and this is "true", then enable all x/wasm proposals.
 	// If EnabledSpecificProposals is "", and this is not "true", then disable all x/wasm proposals.
 	ProposalsEnabled = "true"
@@ -215,6 +251,12 @@ var (
 				upgradeclient.CancelProposalHandler,
 				ibcclientclient.UpdateClientProposalHandler,
 				ibcclientclient.UpgradeProposalHandler,
+				msgfilterclient.DisableMsgsProposalHandler,
+				msgfilterclient.EnableMsgsProposalHandler,
+				ratelimitclient.AddRateLimitProposalHandler,
+				ratelimitclient.UpdateRateLimitProposalHandler,
+				ratelimitclient.RemoveRateLimitProposalHandler,
+				ratelimitclient.ResetRateLimitProposalHandler,
 			)...,
 		),
 		params.AppModuleBasic{},
@@ -229,11 +271,22 @@ var (
 		authzmodule.AppModuleBasic{},
 		wasm.AppModuleBasic{},
 		ica.AppModuleBasic{},
-		intertx.AppModuleBasic{},
+		icaauth.AppModuleBasic{},
+		icq.AppModuleBasic{},
+		tokenfactory.AppModuleBasic{},
+		feeshare.AppModuleBasic{},
+		drip.AppModuleBasic{},
+		ratelimit.AppModuleBasic{},
 		ibcfee.AppModuleBasic{},
 		clock.AppModuleBasic{},
 		ibchooks.AppModuleBasic{},
 		packetforward.AppModuleBasic{},
+		commission.AppModuleBasic{},
+		globalfee.AppModuleBasic{},
+		feetoken.AppModuleBasic{},
+		sponsor.AppModuleBasic{},
+		msglimit.AppModuleBasic{},
+		msgfilter.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -248,6 +301,8 @@ var (
 		ibcfeetypes.ModuleName:         nil,
 		icatypes.ModuleName:            nil,
 		wasm.ModuleName:                {authtypes.Burner},
+		tokenfactorytypes.ModuleName:   {authtypes.Minter, authtypes.Burner},
+		driptypes.ModuleName:           nil,
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -301,13 +356,24 @@ type OraichainApp struct {
 	IBCHooksKeeper      *ibchookskeeper.Keeper
 	icaControllerKeeper icacontrollerkeeper.Keeper
 	icaHostKeeper       icahostkeeper.Keeper
-	interTxKeeper       intertxkeeper.Keeper
 	// Middleware wrapper
-	Ics20WasmHooks      *ibchooks.WasmHooks
+	Ics20WasmHooks      *wasmhooks.WasmHooks
 	HooksICS4Wrapper    ibchooks.ICS4Middleware
 	PacketForwardKeeper *packetforwardkeeper.Keeper
 
 	// custom modules here
+	CommissionKeeper   commissionkeeper.Keeper
+	GlobalFeeKeeper    globalfeekeeper.Keeper
+	FeeTokenKeeper     feetokenkeeper.Keeper
+	SponsorKeeper      sponsorkeeper.Keeper
+	MsgLimitKeeper     msglimitkeeper.Keeper
+	MsgFilterKeeper    msgfilterkeeper.Keeper
+	ICAAuthKeeper      icaauthkeeper.Keeper
+	ICQKeeper          icqkeeper.Keeper
+	TokenFactoryKeeper tokenfactorykeeper.Keeper
+	FeeShareKeeper     feesharekeeper.Keeper
+	DripKeeper         dripkeeper.Keeper
+	RateLimitKeeper    ratelimitkeeper.Keeper
 
 	// make scoped keepers public for test purposes
 	scopedIBCKeeper      capabilitykeeper.ScopedKeeper
@@ -316,7 +382,10 @@ type OraichainApp struct {
 	// scopedIBCFeeKeeper        capabilitykeeper.ScopedKeeper
 	scopedICAHostKeeper       capabilitykeeper.ScopedKeeper
 	scopedICAControllerKeeper capabilitykeeper.ScopedKeeper
-	scopedInterTxKeeper       capabilitykeeper.ScopedKeeper
+	scopedICAAuthKeeper       capabilitykeeper.ScopedKeeper
+	scopedICQKeeper           capabilitykeeper.ScopedKeeper
+	// the capabilities of the replaced intertx module, released by the v0.42.0 upgrade
+	scopedInterTxKeeper capabilitykeeper.ScopedKeeper
 
 	// the module manager
 	mm *module.Manager
@@ -326,12 +395,29 @@ type OraichainApp struct {
 
 	// module configurator
 	configurator module.Configurator
+
+	homePath string
+	config   Config
+	modules  moduleSubset
+
+	// report of the upgrade applied at the previous height, completed with
+	// the store hashes once that height is committed
+	upgradeReport *upgrades.Report
 }
 
 // NewOraichainApp returns a reference to an initialized OraichainApp.
 func NewOraichainApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bool,
 	skipUpgradeHeights map[int64]bool, homePath string, invCheckPeriod uint, encodingConfig appparams.EncodingConfig, enabledProposals []wasm.ProposalType,
 	appOpts servertypes.AppOptions, wasmOpts []wasm.Option, baseAppOptions ...func(*baseapp.BaseApp)) *OraichainApp {
+	return newOraichainApp(logger, db, traceStore, loadLatest, skipUpgradeHeights, homePath, invCheckPeriod, encodingConfig, enabledProposals,
+		appOpts, nil, wasmOpts, baseAppOptions...)
+}
+
+// newOraichainApp returns an OraichainApp with only the modules of the
+// subset registered in its module manager, all of them when nil.
+func newOraichainApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bool,
+	skipUpgradeHeights map[int64]bool, homePath string, invCheckPeriod uint, encodingConfig appparams.EncodingConfig, enabledProposals []wasm.ProposalType,
+	appOpts servertypes.AppOptions, modules moduleSubset, wasmOpts []wasm.Option, baseAppOptions ...func(*baseapp.BaseApp)) *OraichainApp {
 
 	appCodec, legacyAmino := encodingConfig.Codec, encodingConfig.Amino
 	interfaceRegistry := encodingConfig.InterfaceRegistry
@@ -347,10 +433,12 @@ func NewOraichainApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLat
 		govtypes.StoreKey, paramstypes.StoreKey, ibchost.StoreKey, upgradetypes.StoreKey,
 		evidencetypes.StoreKey, ibctransfertypes.StoreKey, capabilitytypes.StoreKey,
 		wasm.StoreKey, feegrant.StoreKey, authzkeeper.StoreKey, icahosttypes.StoreKey,
-		icacontrollertypes.StoreKey, intertxtypes.StoreKey, ibcfeetypes.StoreKey,
+		icacontrollertypes.StoreKey, ibcfeetypes.StoreKey,
 		ibchookstypes.StoreKey, clocktypes.StoreKey, packetforwardtypes.StoreKey,
+		sponsortypes.StoreKey, msgfiltertypes.StoreKey, icqtypes.StoreKey,
+		tokenfactorytypes.StoreKey, feesharetypes.StoreKey, ratelimittypes.StoreKey,
 	)
-	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
+	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, sponsortypes.TStoreKey, relayguard.StoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
 	app := &OraichainApp{
@@ -362,8 +450,20 @@ func NewOraichainApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLat
 		keys:              keys,
 		tkeys:             tkeys,
 		memKeys:           memKeys,
+		homePath:          homePath,
 	}
 
+	appConfig, err := ReadConfig(appOpts)
+	if err != nil {
+		panic("error while reading app config: " + err.Error())
+	}
+	app.config = appConfig
+
+	if err := modules.validate(); err != nil {
+		panic("invalid module subset: " + err.Error())
+	}
+	app.modules = modules
+
 	app.paramsKeeper = initParamsKeeper(appCodec, legacyAmino, keys[paramstypes.StoreKey], tkeys[paramstypes.TStoreKey])
 
 	// set the BaseApp's parameter store
@@ -376,16 +476,20 @@ func NewOraichainApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLat
 	scopedWasmKeeper := app.capabilityKeeper.ScopeToModule(wasm.ModuleName)
 	scopedICAHostKeeper := app.capabilityKeeper.ScopeToModule(icahosttypes.SubModuleName)
 	scopedICAControllerKeeper := app.capabilityKeeper.ScopeToModule(icacontrollertypes.SubModuleName)
-	scopedInterTxKeeper := app.capabilityKeeper.ScopeToModule(intertxtypes.ModuleName)
+	scopedICAAuthKeeper := app.capabilityKeeper.ScopeToModule(icaauthtypes.ModuleName)
+	scopedInterTxKeeper := app.capabilityKeeper.ScopeToModule(v0420.InterTxModuleName)
+	scopedICQKeeper := app.capabilityKeeper.ScopeToModule(icqtypes.ModuleName)
 	app.capabilityKeeper.Seal()
 
 	// add keepers
 	app.accountKeeper = authkeeper.NewAccountKeeper(
 		appCodec, keys[authtypes.StoreKey], app.getSubspace(authtypes.ModuleName), authtypes.ProtoBaseAccount, maccPerms,
 	)
-	app.bankKeeper = bankkeeper.NewBaseKeeper(
+	// the before send hooks of the factory denoms are set once the tokenfactory keeper is built
+	bankKeeper := tokenfactorykeeper.NewBankKeeper(bankkeeper.NewBaseKeeper(
 		appCodec, keys[banktypes.StoreKey], app.accountKeeper, app.getSubspace(banktypes.ModuleName), app.BlockedAddrs(),
-	)
+	))
+	app.bankKeeper = bankKeeper
 	validateKeeper(app.accountKeeper, app.bankKeeper)
 	stakingKeeper := stakingkeeper.NewKeeper(
 		appCodec, keys[stakingtypes.StoreKey], app.accountKeeper, app.bankKeeper, app.getSubspace(stakingtypes.ModuleName),
@@ -413,6 +517,8 @@ func NewOraichainApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLat
 	)
 	app.upgradeKeeper = upgradekeeper.NewKeeper(skipUpgradeHeights, keys[upgradetypes.StoreKey], appCodec, homePath, bApp)
 
+	// the handlers of the msg service router reject disabled msgs, as the
+	// services are registered through msgfilter.NewMsgServer below
 	app.authzKeeper = authzkeeper.NewKeeper(
 		keys[authzkeeper.StoreKey],
 		appCodec,
@@ -425,10 +531,10 @@ func NewOraichainApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLat
 		app.accountKeeper,
 	)
 
-	// NOTE: upgrade feature comment out for new build after proposal done
-	// app.upgradeKeeper.SetUpgradeHandler("ai-oracle", func(ctx sdk.Context, plan upgradetypes.Plan) {
-	// 	// TODO: Add some modification logic here
-	// })
+	app.CommissionKeeper = commissionkeeper.NewKeeper(app.getSubspace(commissiontypes.ModuleName))
+	app.GlobalFeeKeeper = globalfeekeeper.NewKeeper(app.getSubspace(globalfeetypes.ModuleName))
+	app.MsgLimitKeeper = msglimitkeeper.NewKeeper(app.getSubspace(msglimittypes.ModuleName))
+	app.MsgFilterKeeper = msgfilterkeeper.NewKeeper(keys[msgfiltertypes.StoreKey])
 
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
@@ -456,11 +562,21 @@ func NewOraichainApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLat
 	app.IBCHooksKeeper = &hooksKeeper
 
 	validateKeeper(app.IBCHooksKeeper)
-	wasmHooks := ibchooks.NewWasmHooks(app.IBCHooksKeeper, nil, sdk.GetConfig().GetBech32AccountAddrPrefix()) // The contract keeper needs to be set later
+	wasmHooks := wasmhooks.NewWasmHooks(app.IBCHooksKeeper, nil, sdk.GetConfig().GetBech32AccountAddrPrefix()) // The contract keeper needs to be set later
 	app.Ics20WasmHooks = &wasmHooks
 	validateKeeper(app.ibcKeeper, app.ibcKeeper.ChannelKeeper, app.Ics20WasmHooks)
-	app.HooksICS4Wrapper = ibchooks.NewICS4Middleware(
+
+	// The rate limits of the transfers are checked right before the packets
+	// are sent by the channel, after the ibc-hooks strip their callbacks
+	app.RateLimitKeeper = ratelimitkeeper.NewKeeper(
+		keys[ratelimittypes.StoreKey],
+		appCodec,
+		app.bankKeeper,
 		app.ibcKeeper.ChannelKeeper,
+		app.ibcKeeper.ChannelKeeper,
+	)
+	app.HooksICS4Wrapper = ibchooks.NewICS4Middleware(
+		app.RateLimitKeeper,
 		app.Ics20WasmHooks,
 	)
 
@@ -516,7 +632,7 @@ func NewOraichainApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLat
 		&app.ibcKeeper.PortKeeper,
 		app.accountKeeper,
 		scopedICAHostKeeper,
-		app.MsgServiceRouter(),
+		app.MsgServiceRouter(), // disabled msgs get an error ack
 	)
 	validateKeeper(scopedICAControllerKeeper)
 	app.icaControllerKeeper = icacontrollerkeeper.NewKeeper(
@@ -530,10 +646,6 @@ func NewOraichainApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLat
 		app.MsgServiceRouter(),
 	)
 
-	validateKeeper(app.icaControllerKeeper, scopedInterTxKeeper)
-	// For wasmd we use the demo controller from https://github.com/cosmos/interchain-accounts but see notes below
-	app.interTxKeeper = intertxkeeper.NewKeeper(appCodec, keys[intertxtypes.StoreKey], app.icaControllerKeeper, scopedInterTxKeeper)
-
 	// set the contract keeper for the Ics20WasmHooks
 	// just re-use the full router - do we want to limit this more?
 	wasmConfig, err := wasm.ReadWasmConfig(appOpts)
@@ -541,6 +653,13 @@ func NewOraichainApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLat
 		panic("error while reading wasm config: " + err.Error())
 	}
 
+	// msgs dispatched by contracts skip the ante handler, so disabled msgs are filtered here
+	wasmRouter := msgfilter.NewMessageRouter(app.MsgServiceRouter(), app.MsgFilterKeeper, walker.New(appCodec, walker.DefaultMaxDepth))
+
+	// the custom plugins hold a pointer to the keepers set below
+	queryPlugin := wasmbinding.NewQueryPlugin(app.bankKeeper, app.stakingKeeper, app.distrKeeper, app.ibcKeeper, &app.ICAAuthKeeper, &app.TokenFactoryKeeper)
+	wasmOpts = append(wasmOpts, wasmbinding.RegisterCustomPlugins(wasmRouter, queryPlugin)...)
+
 	validateKeeper(scopedWasmKeeper, app.transferKeeper)
 	app.wasmKeeper = wasm.NewKeeper(
 		appCodec,
@@ -554,7 +673,7 @@ func NewOraichainApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLat
 		&app.ibcKeeper.PortKeeper,
 		scopedWasmKeeper,
 		app.transferKeeper,
-		app.MsgServiceRouter(),
+		wasmRouter,
 		app.GRPCQueryRouter(),
 		filepath.Join(homePath, "wasm"),
 		wasmConfig,
@@ -568,12 +687,61 @@ func NewOraichainApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLat
 	validateKeeper(app.ContractKeeper)
 	app.Ics20WasmHooks.ContractKeeper = app.ContractKeeper
 
+	// contracts are registered by their admins and called every block, so this needs the wasm keeper
 	app.ClockKeeper = clockkeeper.NewKeeper(
 		app.keys[clocktypes.StoreKey],
 		appCodec,
-		*app.ContractKeeper,
+		app.getSubspace(clocktypes.ModuleName),
+		app.wasmKeeper,
+	)
+
+	// contracts owning interchain accounts are called back, so this needs the wasm keeper
+	validateKeeper(app.icaControllerKeeper, scopedICAAuthKeeper)
+	app.ICAAuthKeeper = icaauthkeeper.NewKeeper(appCodec, app.getSubspace(icaauthtypes.ModuleName), app.icaControllerKeeper, scopedICAAuthKeeper, app.wasmKeeper)
+
+	// the host answers queries with the app's gRPC router and stores, and
+	// contracts sending queries are called back, so this needs the wasm keeper
+	validateKeeper(scopedICQKeeper)
+	app.ICQKeeper = icqkeeper.NewKeeper(
+		keys[icqtypes.StoreKey],
+		appCodec,
+		app.getSubspace(icqtypes.ModuleName),
+		app.ibcKeeper.ChannelKeeper,
+		app.ibcKeeper.ChannelKeeper,
+		&app.ibcKeeper.PortKeeper,
+		scopedICQKeeper,
+		app.wasmKeeper,
+		app.GRPCQueryRouter(),
+		keys,
 	)
 
+	// denom admins may set contracts as before send hooks, so this needs the wasm keeper
+	app.TokenFactoryKeeper = tokenfactorykeeper.NewKeeper(
+		keys[tokenfactorytypes.StoreKey],
+		appCodec,
+		app.getSubspace(tokenfactorytypes.ModuleName),
+		app.accountKeeper,
+		app.bankKeeper,
+		app.distrKeeper,
+		app.wasmKeeper,
+	)
+	bankKeeper.SetHooks(app.TokenFactoryKeeper)
+
+	// only the admins or creators of contracts may register their fee shares
+	app.FeeShareKeeper = feesharekeeper.NewKeeper(
+		keys[feesharetypes.StoreKey],
+		appCodec,
+		app.getSubspace(feesharetypes.ModuleName),
+		app.bankKeeper,
+		app.wasmKeeper,
+	)
+
+	app.DripKeeper = dripkeeper.NewKeeper(app.getSubspace(driptypes.ModuleName), app.accountKeeper, app.bankKeeper)
+
+	// fee token rates may come from a contract, so this needs the wasm keeper
+	app.FeeTokenKeeper = feetokenkeeper.NewKeeper(app.getSubspace(feetokentypes.ModuleName), app.wasmKeeper)
+	app.SponsorKeeper = sponsorkeeper.NewKeeper(keys[sponsortypes.StoreKey], tkeys[sponsortypes.TStoreKey], appCodec, app.wasmKeeper)
+
 	// register the proposal types
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
@@ -581,7 +749,8 @@ func NewOraichainApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLat
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper)).
 		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.ibcKeeper.ClientKeeper)).
-		AddRoute(clocktypes.RouterKey, clockkeeper.NewClockProposalHandler(app.ClockKeeper))
+		AddRoute(msgfiltertypes.RouterKey, msgfilterkeeper.NewProposalHandler(app.MsgFilterKeeper)).
+		AddRoute(ratelimittypes.RouterKey, ratelimitkeeper.NewProposalHandler(app.RateLimitKeeper))
 
 	// The gov proposal types can be individually enabled
 	if len(enabledProposals) != 0 {
@@ -599,17 +768,15 @@ func NewOraichainApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLat
 		packetforwardkeeper.DefaultRefundTransferPacketTimeoutTimestamp,
 	)
 	transferStack = ibcfee.NewIBCMiddleware(transferStack, app.ibcFeeKeeper)
+	// below ibc-hooks, as the ratelimit keeper is below the hooks ICS4 wrapper
+	transferStack = ratelimit.NewIBCMiddleware(transferStack, app.RateLimitKeeper)
 	transferStack = ibchooks.NewIBCMiddleware(transferStack, &app.HooksICS4Wrapper)
 
 	// Create Interchain Accounts Stack
 	// SendPacket, since it is originating from the application to core IBC:
-	// icaAuthModuleKeeper.SendTx -> icaController.SendPacket -> fee.SendPacket -> channel.SendPacket
-
-	// Note: please do your research before using this in production app, this is a demo and not an officially
-	// supported IBC team implementation. Do your own research before using it.
+	// icaauthKeeper.SubmitTx -> icaController.SendPacket -> fee.SendPacket -> channel.SendPacket
 	var icaControllerStack porttypes.IBCModule
-	// You will likely want to use your own reviewed and maintained ica auth module
-	icaControllerStack = intertx.NewIBCModule(app.interTxKeeper)
+	icaControllerStack = icaauth.NewIBCModule(app.ICAAuthKeeper)
 	icaControllerStack = icacontroller.NewIBCMiddleware(icaControllerStack, app.icaControllerKeeper)
 	icaControllerStack = ibcfee.NewIBCMiddleware(icaControllerStack, app.ibcFeeKeeper)
 
@@ -628,9 +795,10 @@ func NewOraichainApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLat
 	ibcRouter := porttypes.NewRouter().
 		AddRoute(ibctransfertypes.ModuleName, transferStack).
 		AddRoute(wasm.ModuleName, wasmStack).
-		AddRoute(intertxtypes.ModuleName, icaControllerStack).
+		AddRoute(icaauthtypes.ModuleName, icaControllerStack).
 		AddRoute(icacontrollertypes.SubModuleName, icaControllerStack).
-		AddRoute(icahosttypes.SubModuleName, icaHostStack)
+		AddRoute(icahosttypes.SubModuleName, icaHostStack).
+		AddRoute(icqtypes.ModuleName, icq.NewIBCModule(app.ICQKeeper))
 
 	app.ibcKeeper.SetRouter(ibcRouter)
 
@@ -652,21 +820,22 @@ func NewOraichainApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLat
 
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
-	app.mm = module.NewManager(
+	app.mm = module.NewManager(app.modules.appModules(
 		genutil.NewAppModule(
 			app.accountKeeper, app.stakingKeeper, app.BaseApp.DeliverTx,
 			encodingConfig.TxConfig,
 		),
 		auth.NewAppModule(appCodec, app.accountKeeper, authsims.RandomGenesisAccounts),
 		vesting.NewAppModule(app.accountKeeper, app.bankKeeper),
-		bank.NewAppModule(appCodec, app.bankKeeper, app.accountKeeper),
+		tokenfactory.NewBankAppModule(appCodec, bankKeeper, app.accountKeeper),
 		capability.NewAppModule(appCodec, *app.capabilityKeeper),
 		crisis.NewAppModule(&app.crisisKeeper, skipGenesisInvariants),
 		gov.NewAppModule(appCodec, app.govKeeper, app.accountKeeper, app.bankKeeper),
 		mint.NewAppModule(appCodec, app.mintKeeper, app.accountKeeper),
 		slashing.NewAppModule(appCodec, app.slashingKeeper, app.accountKeeper, app.bankKeeper, app.stakingKeeper),
 		distr.NewAppModule(appCodec, app.distrKeeper, app.accountKeeper, app.bankKeeper, app.stakingKeeper),
-		staking.NewAppModule(appCodec, app.stakingKeeper, app.accountKeeper, app.bankKeeper),
+		// staking msgs are validated against the commission floor whatever their entry path
+		commission.NewStakingAppModule(appCodec, app.stakingKeeper, app.accountKeeper, app.bankKeeper, app.CommissionKeeper),
 		upgrade.NewAppModule(app.upgradeKeeper),
 		wasm.NewAppModule(appCodec, &app.wasmKeeper, app.stakingKeeper, app.accountKeeper, app.bankKeeper),
 		evidence.NewAppModule(app.evidenceKeeper),
@@ -677,20 +846,33 @@ func NewOraichainApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLat
 		transfer.NewAppModule(app.transferKeeper),
 		ibcfee.NewAppModule(app.ibcFeeKeeper),
 		ica.NewAppModule(&app.icaControllerKeeper, &app.icaHostKeeper),
-		intertx.NewAppModule(appCodec, app.interTxKeeper),
-		clock.NewAppModule(appCodec, app.ClockKeeper),
+		icaauth.NewAppModule(app.ICAAuthKeeper),
+		icq.NewAppModule(app.ICQKeeper),
+		tokenfactory.NewAppModule(app.TokenFactoryKeeper, app.accountKeeper),
+		feeshare.NewAppModule(app.FeeShareKeeper),
+		drip.NewAppModule(app.DripKeeper, app.accountKeeper),
+		ratelimit.NewAppModule(app.RateLimitKeeper),
+		clock.NewAppModule(app.ClockKeeper),
 		ibchooks.NewAppModule(app.accountKeeper),
 		packetforward.NewAppModule(app.PacketForwardKeeper),
-	)
+		commission.NewAppModule(app.CommissionKeeper),
+		globalfee.NewAppModule(app.GlobalFeeKeeper),
+		feetoken.NewAppModule(app.FeeTokenKeeper),
+		sponsor.NewAppModule(app.SponsorKeeper),
+		msglimit.NewAppModule(app.MsgLimitKeeper),
+		msgfilter.NewAppModule(app.MsgFilterKeeper),
+	)...)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
 	// there is nothing left over in the validator fee pool, so as to keep the
 	// CanWithdrawInvariant invariant.
 	// NOTE: staking module is required if HistoricalEntries param > 0
-	app.mm.SetOrderBeginBlockers(
+	app.mm.SetOrderBeginBlockers(app.modules.moduleNames(
 		upgradetypes.ModuleName,
 		capabilitytypes.ModuleName,
 		minttypes.ModuleName,
+		// moves the drips to the fee collector before distr allocates the fees
+		driptypes.ModuleName,
 		distrtypes.ModuleName,
 		slashingtypes.ModuleName,
 		evidencetypes.ModuleName,
@@ -710,12 +892,22 @@ func NewOraichainApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLat
 		icatypes.ModuleName,
 		packetforwardtypes.ModuleName,
 		ibcfeetypes.ModuleName,
-		intertxtypes.ModuleName,
+		icaauthtypes.ModuleName,
+		icqtypes.ModuleName,
+		tokenfactorytypes.ModuleName,
+		feesharetypes.ModuleName,
+		ratelimittypes.ModuleName,
 		wasm.ModuleName,
 		ibchookstypes.ModuleName,
 		clocktypes.ModuleName,
-	)
-	app.mm.SetOrderEndBlockers(
+		commissiontypes.ModuleName,
+		globalfeetypes.ModuleName,
+		feetokentypes.ModuleName,
+		sponsortypes.ModuleName,
+		msglimittypes.ModuleName,
+		msgfiltertypes.ModuleName,
+	)...)
+	app.mm.SetOrderEndBlockers(app.modules.moduleNames(
 		crisistypes.ModuleName,
 		govtypes.ModuleName,
 		stakingtypes.ModuleName,
@@ -738,11 +930,22 @@ func NewOraichainApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLat
 		icatypes.ModuleName,
 		packetforwardtypes.ModuleName,
 		ibcfeetypes.ModuleName,
-		intertxtypes.ModuleName,
+		icaauthtypes.ModuleName,
+		icqtypes.ModuleName,
+		tokenfactorytypes.ModuleName,
+		feesharetypes.ModuleName,
+		driptypes.ModuleName,
+		ratelimittypes.ModuleName,
 		wasm.ModuleName,
 		ibchookstypes.ModuleName,
 		clocktypes.ModuleName,
-	)
+		commissiontypes.ModuleName,
+		globalfeetypes.ModuleName,
+		feetokentypes.ModuleName,
+		sponsortypes.ModuleName,
+		msglimittypes.ModuleName,
+		msgfiltertypes.ModuleName,
+	)...)
 
 	// NOTE: The genutils module must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
@@ -751,7 +954,7 @@ func NewOraichainApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLat
 	// can do so safely.
 	// NOTE: wasm module should be at the end as it can call other module functionality direct or via message dispatching during
 	// genesis phase. For example bank transfer, auth account check, staking, ...
-	app.mm.SetOrderInitGenesis(
+	app.mm.SetOrderInitGenesis(app.modules.moduleNames(
 		capabilitytypes.ModuleName,
 		authtypes.ModuleName,
 		banktypes.ModuleName,
@@ -761,6 +964,15 @@ func NewOraichainApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLat
 		govtypes.ModuleName,
 		minttypes.ModuleName,
 		crisistypes.ModuleName,
+		// the ante handler and the staking msg server read these modules while
+		// delivering the gentxs
+		commissiontypes.ModuleName,
+		globalfeetypes.ModuleName,
+		feetokentypes.ModuleName,
+		sponsortypes.ModuleName,
+		msglimittypes.ModuleName,
+		msgfiltertypes.ModuleName,
+		feesharetypes.ModuleName,
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
 		authz.ModuleName,
@@ -774,16 +986,21 @@ func NewOraichainApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLat
 		icatypes.ModuleName,
 		packetforwardtypes.ModuleName,
 		ibcfeetypes.ModuleName,
-		intertxtypes.ModuleName,
+		icaauthtypes.ModuleName,
+		icqtypes.ModuleName,
+		tokenfactorytypes.ModuleName,
+		driptypes.ModuleName,
+		ratelimittypes.ModuleName,
 		// wasm after ibc transfer
 		wasm.ModuleName,
 		ibchookstypes.ModuleName,
 		clocktypes.ModuleName,
-	)
+	)...)
 
 	app.mm.RegisterInvariants(&app.crisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
-	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
+	// every msg service rejects disabled msgs, whatever executes them
+	app.configurator = module.NewConfigurator(app.appCodec, msgfilter.NewMsgServer(app.MsgServiceRouter(), app.MsgFilterKeeper), app.GRPCQueryRouter())
 	app.mm.RegisterServices(app.configurator)
 
 	// add test gRPC service for testing gRPC queries in isolation
@@ -831,6 +1048,16 @@ func NewOraichainApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLat
 			TxCounterStoreKey: keys[wasm.StoreKey],
 			WasmConfig:        wasmConfig,
 			Cdc:               appCodec,
+			CommissionKeeper:  &app.CommissionKeeper,
+			GlobalFeeKeeper:   &app.GlobalFeeKeeper,
+			FeeTokenKeeper:    &app.FeeTokenKeeper,
+			SponsorKeeper:     &app.SponsorKeeper,
+			MsgLimitKeeper:    &app.MsgLimitKeeper,
+			MsgFilterKeeper:   &app.MsgFilterKeeper,
+			FeeShareKeeper:    &app.FeeShareKeeper,
+			RelayTracker: relayguard.NewTracker(relayguard.DefaultConfig(), tkeys[relayguard.StoreKey], func() sdk.Context {
+				return app.NewContext(true, tmproto.Header{})
+			}),
 		},
 	)
 	if err != nil {
@@ -857,10 +1084,14 @@ func NewOraichainApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLat
 	app.upgradeHandler()
 
 	if loadLatest {
+		if err := app.validateMountedStores(db); err != nil {
+			tmos.Exit(fmt.Sprintf("invalid store upgrades: %s", err))
+		}
 		if err := app.LoadLatestVersion(); err != nil {
 			tmos.Exit(fmt.Sprintf("failed to load latest version: %s", err))
 		}
 		ctx := app.BaseApp.NewUncachedContext(true, tmproto.Header{})
+		app.loadUpgradeReport(ctx)
 
 		// Initialize pinned codes in wasmvm as they are not persisted there
 		if err := app.wasmKeeper.InitializePinnedCodes(ctx); err != nil {
@@ -873,8 +1104,9 @@ func NewOraichainApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLat
 	app.scopedWasmKeeper = scopedWasmKeeper
 	app.scopedICAHostKeeper = scopedICAHostKeeper
 	app.scopedICAControllerKeeper = scopedICAControllerKeeper
+	app.scopedICAAuthKeeper = scopedICAAuthKeeper
+	app.scopedICQKeeper = scopedICQKeeper
 	app.scopedInterTxKeeper = scopedInterTxKeeper
-	clockkeeper.RegisterProposalTypes()
 	return app
 }
 
@@ -883,7 +1115,9 @@ func (app *OraichainApp) Name() string { return app.BaseApp.Name() }
 
 // application updates every begin block
 func (app *OraichainApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
-	return app.mm.BeginBlock(ctx, req)
+	res := app.mm.BeginBlock(ctx, req)
+	res.Events = append(res.Events, app.completeUpgradeReport(ctx)...)
+	return res
 }
 
 // EndBlocker application updates every end block
@@ -954,17 +1188,23 @@ func (app *OraichainApp) getSubspace(moduleName string) paramstypes.Subspace {
 // API server.
 func (app *OraichainApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfig) {
 	clientCtx := apiSvr.ClientCtx
-	rpc.RegisterRoutes(clientCtx, apiSvr.Router)
-	// Register legacy tx routes.
-	authrest.RegisterTxRoutes(clientCtx, apiSvr.Router)
-	// Register new tx routes from grpc-gateway.
-	authtx.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
-	// Register new tendermint queries routes from grpc-gateway.
-	tmservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
-
-	// Register legacy and grpc-gateway routes for all modules.
-	ModuleBasics.RegisterRESTRoutes(clientCtx, apiSvr.Router)
-	ModuleBasics.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+
+	if app.config.LegacyREST {
+		rpc.RegisterRoutes(clientCtx, apiSvr.Router)
+		// Register legacy tx routes.
+		authrest.RegisterTxRoutes(clientCtx, apiSvr.Router)
+		// Register legacy routes for all modules.
+		ModuleBasics.RegisterRESTRoutes(clientCtx, apiSvr.Router)
+	}
+
+	if app.config.GRPCGateway {
+		// Register new tx routes from grpc-gateway.
+		authtx.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+		// Register new tendermint queries routes from grpc-gateway.
+		tmservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+		// Register grpc-gateway routes for all modules.
+		ModuleBasics.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	}
 
 	// register swagger API from root so that other applications can override easily
 	if apiConfig.Swagger {
@@ -1027,30 +1267,19 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(wasm.ModuleName)
 	paramsKeeper.Subspace(ibchookstypes.ModuleName)
 	paramsKeeper.Subspace(clocktypes.ModuleName)
+	paramsKeeper.Subspace(commissiontypes.ModuleName)
+	paramsKeeper.Subspace(globalfeetypes.ModuleName)
+	paramsKeeper.Subspace(feetokentypes.ModuleName)
+	paramsKeeper.Subspace(msglimittypes.ModuleName)
+	paramsKeeper.Subspace(icaauthtypes.ModuleName)
+	paramsKeeper.Subspace(icqtypes.ModuleName)
+	paramsKeeper.Subspace(tokenfactorytypes.ModuleName)
+	paramsKeeper.Subspace(feesharetypes.ModuleName)
+	paramsKeeper.Subspace(driptypes.ModuleName)
 
 	return paramsKeeper
 }
 
-func (app *OraichainApp) upgradeHandler() {
-	app.upgradeKeeper.SetUpgradeHandler(BinaryVersion, func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
-		ctx.Logger().Info("start to migrate modules...")
-		ctx.Logger().Info("vm module: %v\n", fromVM)
-		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
-	})
-
-	upgradeInfo, err := app.upgradeKeeper.ReadUpgradeInfoFromDisk()
-	if err != nil {
-		panic(err)
-	}
-
-	if upgradeInfo.Name == BinaryVersion && !app.upgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
-		// configure store loader that checks if version == upgradeHeight and applies store upgrades
-		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storetypes.StoreUpgrades{
-			Added: []string{},
-		}))
-	}
-}
-
 // AllCapabilities returns all capabilities available with the current wasmvm
 // See https://github.com/CosmWasm/cosmwasm/blob/main/docs/CAPABILITIES-BUILT-IN.md
 // This functionality is going to be moved upstream: https://github.com/CosmWasm/wasmvm/issues/425
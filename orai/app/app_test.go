@@ -6,7 +6,15 @@ import (
 	"testing"
 
 	"github.com/CosmWasm/wasmd/x/wasm"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -27,7 +35,7 @@ func (ao EmptyAppOptions) Get(o string) interface{} {
 
 func TestWasmdExport(t *testing.T) {
 	db := db.NewMemDB()
-	gapp := NewOraichainApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, DefaultNodeHome, 0, MakeEncodingConfig(), wasm.EnableAllProposals, EmptyAppOptions{}, emptyWasmOpts)
+	gapp := NewOraichainApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, t.TempDir(), 0, MakeEncodingConfig(), wasm.EnableAllProposals, EmptyAppOptions{}, emptyWasmOpts)
 
 	genesisState := NewDefaultGenesisState(gapp.appCodec)
 	stateBytes, err := json.MarshalIndent(genesisState, "", "  ")
@@ -54,13 +62,90 @@ func TestWasmdExport(t *testing.T) {
 // ensure that blocked addresses are properly set in bank keeper
 func TestBlockedAddrs(t *testing.T) {
 	db := db.NewMemDB()
-	gapp := NewOraichainApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, DefaultNodeHome, 0, MakeEncodingConfig(), wasm.EnableAllProposals, EmptyAppOptions{}, emptyWasmOpts)
+	gapp := NewOraichainApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, t.TempDir(), 0, MakeEncodingConfig(), wasm.EnableAllProposals, EmptyAppOptions{}, emptyWasmOpts)
 
 	for acc := range maccPerms {
 		require.Equal(t, !allowedReceivingModAcc[acc], gapp.bankKeeper.BlockedAddr(gapp.accountKeeper.GetModuleAddress(acc)))
 	}
 }
 
+// ensure that the commission floor is applied to staking msgs routed through
+// the msg service router, as done by authz, wasm and interchain accounts
+func TestStakingMsgsRespectCommissionFloor(t *testing.T) {
+	db := db.NewMemDB()
+	gapp := NewOraichainApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, t.TempDir(), 0, MakeEncodingConfig(), wasm.EnableAllProposals, EmptyAppOptions{}, emptyWasmOpts)
+
+	genesisState := NewDefaultGenesisState(gapp.appCodec)
+	stateBytes, err := json.MarshalIndent(genesisState, "", "  ")
+	require.NoError(t, err)
+	gapp.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+	gapp.Commit()
+
+	ctx := gapp.NewContext(true, tmproto.Header{Height: gapp.LastBlockHeight()})
+	valAddr := sdk.ValAddress("val1________________")
+	rate := sdk.NewDecWithPrec(1, 2)
+
+	createMsg, err := stakingtypes.NewMsgCreateValidator(
+		valAddr, ed25519.GenPrivKey().PubKey(), sdk.NewInt64Coin("orai", 1), stakingtypes.Description{Moniker: "val"},
+		stakingtypes.NewCommissionRates(rate, sdk.OneDec(), sdk.OneDec()), sdk.OneInt(),
+	)
+	require.NoError(t, err)
+	editMsg := stakingtypes.NewMsgEditValidator(valAddr, stakingtypes.Description{Moniker: "val"}, &rate, nil)
+
+	for _, msg := range []sdk.Msg{createMsg, editMsg} {
+		handler := gapp.MsgServiceRouter().Handler(msg)
+		require.NotNil(t, handler)
+		_, err = handler(ctx, msg)
+		require.ErrorContains(t, err, "commission can't be lower than")
+	}
+}
+
+// ensure that a chain starts from gentxs, which go through the ante handler
+// and the msg servers before the modules following genutil are initialised
+func TestInitChainWithGenTx(t *testing.T) {
+	encodingConfig := MakeEncodingConfig()
+	gapp := NewOraichainApp(log.NewNopLogger(), db.NewMemDB(), nil, true, map[int64]bool{}, t.TempDir(), 0, encodingConfig, wasm.EnableAllProposals, EmptyAppOptions{}, emptyWasmOpts)
+	genesisState := NewDefaultGenesisState(gapp.appCodec)
+
+	privKey := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(privKey.PubKey().Address())
+	genAcc, err := codectypes.NewAnyWithValue(authtypes.NewBaseAccount(addr, nil, 0, 0))
+	require.NoError(t, err)
+	authGenesis := authtypes.DefaultGenesisState()
+	authGenesis.Accounts = append(authGenesis.Accounts, genAcc)
+	genesisState[authtypes.ModuleName] = gapp.appCodec.MustMarshalJSON(authGenesis)
+	bankGenesis := banktypes.DefaultGenesisState()
+	bankGenesis.Balances = append(bankGenesis.Balances, banktypes.Balance{
+		Address: addr.String(),
+		Coins:   sdk.NewCoins(sdk.NewInt64Coin("orai", 1_000_000_000_000)),
+	})
+	genesisState[banktypes.ModuleName] = gapp.appCodec.MustMarshalJSON(bankGenesis)
+
+	rate := sdk.NewDecWithPrec(1, 1)
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		sdk.ValAddress(addr), ed25519.GenPrivKey().PubKey(), sdk.NewInt64Coin("orai", 100_000_000), stakingtypes.Description{Moniker: "val"},
+		stakingtypes.NewCommissionRates(rate, sdk.OneDec(), sdk.OneDec()), sdk.OneInt(),
+	)
+	require.NoError(t, err)
+	chainID := "gentx-test"
+	genTx, err := helpers.GenTx(encodingConfig.TxConfig, []sdk.Msg{msg}, sdk.NewCoins(), helpers.DefaultGenTxGas, chainID, []uint64{0}, []uint64{0}, privKey)
+	require.NoError(t, err)
+	genTxBz, err := encodingConfig.TxConfig.TxJSONEncoder()(genTx)
+	require.NoError(t, err)
+	genesisState[genutiltypes.ModuleName] = gapp.appCodec.MustMarshalJSON(genutiltypes.NewGenesisState([]json.RawMessage{genTxBz}))
+
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+	res := gapp.InitChain(abci.RequestInitChain{ChainId: chainID, AppStateBytes: stateBytes})
+	require.Len(t, res.Validators, 1)
+	gapp.Commit()
+
+	ctx := gapp.NewContext(true, tmproto.Header{Height: gapp.LastBlockHeight()})
+	validator, found := gapp.stakingKeeper.GetValidator(ctx, sdk.ValAddress(addr))
+	require.True(t, found)
+	require.Equal(t, rate, validator.Commission.Rate)
+}
+
 func TestGetMaccPerms(t *testing.T) {
 	dup := GetMaccPerms()
 	require.Equal(t, maccPerms, dup, "duplicated module account permissions differed from actual module account permissions")
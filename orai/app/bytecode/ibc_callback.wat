@@ -0,0 +1,42 @@
+;; Source of ibc_callback.wasm, a minimal CosmWasm contract used by the
+;; ibc_callback tests. Its sudo entry point stores the msg it gets under the
+;; raw key "last", so that the tests can read back the ibc_lifecycle_complete
+;; msgs sent to it. Regions are {offset, capacity, length} u32 triples.
+(module
+  (type (func))
+  (type (func (param i32) (result i32)))
+  (type (func (param i32)))
+  (type (func (param i32 i32 i32) (result i32)))
+  (type (func (param i32 i32) (result i32)))
+  (type (func (param i32 i32)))
+  (import "env" "db_write" (func $db_write (type 5)))
+  (memory (export "memory") 4)
+  (global $heap (mut i32) (i32.const 1024))
+
+  (func (export "interface_version_8") (type 0))
+
+  ;; bump allocator, the memory of an instance is dropped after each call
+  (func (export "allocate") (type 1) (param $size i32) (result i32)
+    (local $region i32)
+    (local.set $region (global.get $heap))
+    (i32.store offset=0 (local.get $region) (i32.add (local.get $region) (i32.const 12)))
+    (i32.store offset=4 (local.get $region) (local.get $size))
+    (i32.store offset=8 (local.get $region) (i32.const 0))
+    (global.set $heap (i32.add (i32.add (local.get $region) (i32.const 12)) (local.get $size)))
+    (local.get $region))
+
+  (func (export "deallocate") (type 2) (param i32))
+
+  (func (export "instantiate") (type 3) (param i32 i32 i32) (result i32)
+    (i32.const 100))
+
+  (func (export "sudo") (type 4) (param $env i32) (param $msg i32) (result i32)
+    (call $db_write (i32.const 112) (local.get $msg))
+    (i32.const 100))
+
+  ;; region of the empty response
+  (data (i32.const 100) "\80\00\00\00\09\00\00\00\09\00\00\00")
+  ;; region of the "last" key
+  (data (i32.const 112) "\c8\00\00\00\04\00\00\00\04\00\00\00")
+  (data (i32.const 128) "{\"ok\":{}}")
+  (data (i32.const 200) "last"))
@@ -0,0 +1,106 @@
+package app
+
+import (
+	"os"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	clockkeeper "github.com/oraichain/orai/x/clock/keeper"
+	clocktypes "github.com/oraichain/orai/x/clock/types"
+)
+
+// ensure that only the admin registers a contract, that it is jailed once it
+// fails max errors calls in a row, and that the admin unjails it
+func TestClock(t *testing.T) {
+	gapp, ctx := setupWasmBindingApp(t)
+	msgServer := clockkeeper.NewMsgServerImpl(gapp.ClockKeeper)
+	querier := clockkeeper.NewQuerier(gapp.ClockKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	admin := sdk.AccAddress("admin_______________")
+	other := sdk.AccAddress("other_______________")
+
+	// the reflect contract has no sudo entry point, so every call fails
+	code, err := os.ReadFile("./bytecode/reflect.wasm")
+	require.NoError(t, err)
+	codeID, _, err := gapp.ContractKeeper.Create(ctx, admin, code, nil)
+	require.NoError(t, err)
+	contract, _, err := gapp.ContractKeeper.Instantiate(ctx, codeID, admin, admin, []byte(`{}`), "reflect", nil)
+	require.NoError(t, err)
+
+	params := gapp.ClockKeeper.GetParams(ctx)
+	_, err = msgServer.RegisterClockContract(goCtx, clocktypes.NewMsgRegisterClockContract(other, contract, 0))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.RegisterClockContract(goCtx, clocktypes.NewMsgRegisterClockContract(admin, contract, params.ContractGasLimit+1))
+	require.ErrorIs(t, err, clocktypes.ErrInvalidGasLimit)
+	_, err = msgServer.RegisterClockContract(goCtx, clocktypes.NewMsgRegisterClockContract(admin, contract, 0))
+	require.NoError(t, err)
+	_, err = msgServer.RegisterClockContract(goCtx, clocktypes.NewMsgRegisterClockContract(admin, contract, 0))
+	require.ErrorIs(t, err, clocktypes.ErrContractRegistered)
+
+	c, found := gapp.ClockKeeper.GetClockContract(ctx, contract)
+	require.True(t, found)
+	require.Equal(t, params.ContractGasLimit, c.GasLimit)
+
+	for i := uint64(1); i <= params.MaxErrors; i++ {
+		gapp.ClockKeeper.CallClockContracts(ctx)
+		c, _ = gapp.ClockKeeper.GetClockContract(ctx, contract)
+		require.Equal(t, i, c.ErrorCount)
+	}
+	require.True(t, c.IsJailed)
+
+	// jailed contracts are no longer called
+	gapp.ClockKeeper.CallClockContracts(ctx)
+	c, _ = gapp.ClockKeeper.GetClockContract(ctx, contract)
+	require.Equal(t, params.MaxErrors, c.ErrorCount)
+
+	jailedRes, err := querier.JailedClockContracts(goCtx, &clocktypes.QueryJailedClockContractsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []clocktypes.ClockContract{c}, jailedRes.ClockContracts)
+
+	_, err = msgServer.UnjailClockContract(goCtx, clocktypes.NewMsgUnjailClockContract(other, contract))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.UnjailClockContract(goCtx, clocktypes.NewMsgUnjailClockContract(admin, contract))
+	require.NoError(t, err)
+	_, err = msgServer.UnjailClockContract(goCtx, clocktypes.NewMsgUnjailClockContract(admin, contract))
+	require.ErrorIs(t, err, clocktypes.ErrContractNotJailed)
+
+	jailedRes, err = querier.JailedClockContracts(goCtx, &clocktypes.QueryJailedClockContractsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, jailedRes.ClockContracts)
+	contractsRes, err := querier.ClockContracts(goCtx, &clocktypes.QueryClockContractsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []clocktypes.ClockContract{clocktypes.NewClockContract(contract, params.ContractGasLimit)}, contractsRes.ClockContracts)
+
+	_, err = msgServer.UnregisterClockContract(goCtx, clocktypes.NewMsgUnregisterClockContract(admin, contract))
+	require.NoError(t, err)
+	_, found = gapp.ClockKeeper.GetClockContract(ctx, contract)
+	require.False(t, found)
+}
+
+// ensure that the contracts listed in the params of the Juno clock module
+// are registered with its gas limit
+func TestClockMigrate1to2(t *testing.T) {
+	gapp, ctx := setupWasmBindingApp(t)
+	contract := sdk.AccAddress("contract____________")
+
+	var legacyParams []byte
+	legacyParams = protowire.AppendTag(legacyParams, 1, protowire.BytesType)
+	legacyParams = protowire.AppendString(legacyParams, contract.String())
+	legacyParams = protowire.AppendTag(legacyParams, 1, protowire.BytesType)
+	legacyParams = protowire.AppendString(legacyParams, "invalid")
+	legacyParams = protowire.AppendTag(legacyParams, 2, protowire.VarintType)
+	legacyParams = protowire.AppendVarint(legacyParams, 500_000)
+	store := ctx.KVStore(gapp.keys[clocktypes.StoreKey])
+	store.Set(clocktypes.LegacyParamsKey, legacyParams)
+
+	require.NoError(t, clockkeeper.NewMigrator(gapp.ClockKeeper).Migrate1to2(ctx))
+
+	require.False(t, store.Has(clocktypes.LegacyParamsKey))
+	require.Equal(t, clocktypes.NewParams(500_000, clocktypes.DefaultParams().MaxErrors), gapp.ClockKeeper.GetParams(ctx))
+	require.Equal(t, []clocktypes.ClockContract{clocktypes.NewClockContract(contract, 500_000)}, gapp.ClockKeeper.GetAllClockContracts(ctx))
+}
@@ -0,0 +1,71 @@
+package app
+
+import (
+	"fmt"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+const (
+	// FlagLegacyREST enables the legacy REST routes of the API server
+	FlagLegacyREST = "orai.legacy-rest"
+	// FlagGRPCGateway enables the grpc-gateway routes of the API server
+	FlagGRPCGateway = "orai.grpc-gateway"
+)
+
+// Config defines the settings of a node read from the [orai] section of
+// app.toml. Swagger is served according to api.swagger.
+//
+// Wasm query plugins are not configurable: contracts query them when
+// executing txs, so they must be the same on every node.
+type Config struct {
+	// LegacyREST registers the legacy REST routes on the API server
+	LegacyREST bool `mapstructure:"legacy-rest"`
+
+	// GRPCGateway registers the grpc-gateway routes on the API server
+	GRPCGateway bool `mapstructure:"grpc-gateway"`
+}
+
+// DefaultConfig returns the config of a node, serving every API route.
+func DefaultConfig() Config {
+	return Config{
+		LegacyREST:  true,
+		GRPCGateway: true,
+	}
+}
+
+// ReadConfig reads the config from the app options, defaulting the missing
+// settings.
+func ReadConfig(appOpts servertypes.AppOptions) (Config, error) {
+	cfg := DefaultConfig()
+
+	var err error
+	if v := appOpts.Get(FlagLegacyREST); v != nil {
+		if cfg.LegacyREST, err = cast.ToBoolE(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagLegacyREST, err)
+		}
+	}
+	if v := appOpts.Get(FlagGRPCGateway); v != nil {
+		if cfg.GRPCGateway, err = cast.ToBoolE(v); err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", FlagGRPCGateway, err)
+		}
+	}
+
+	return cfg, nil
+}
+
+// ConfigTemplate is the [orai] section of app.toml.
+const ConfigTemplate = `
+###############################################################################
+###                           Orai Configuration                            ###
+###############################################################################
+
+[orai]
+
+# Register the legacy REST routes on the API server.
+legacy-rest = {{ .Orai.LegacyREST }}
+
+# Register the grpc-gateway routes on the API server.
+grpc-gateway = {{ .Orai.GRPCGateway }}
+`
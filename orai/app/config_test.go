@@ -0,0 +1,45 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// appOptionsMap is a stub implementing AppOptions with fixed values
+type appOptionsMap map[string]interface{}
+
+// Get implements AppOptions
+func (m appOptionsMap) Get(key string) interface{} {
+	return m[key]
+}
+
+func TestReadConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    appOptionsMap
+		want    Config
+		wantErr string
+	}{
+		{"defaults", appOptionsMap{}, DefaultConfig(), ""},
+		{
+			"disabled routes",
+			appOptionsMap{FlagLegacyREST: false, FlagGRPCGateway: "false"},
+			Config{},
+			"",
+		},
+		{"invalid bool", appOptionsMap{FlagLegacyREST: "maybe"}, Config{}, "invalid orai.legacy-rest"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg, err := ReadConfig(tc.opts)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, cfg)
+		})
+	}
+}
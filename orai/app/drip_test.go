@@ -0,0 +1,47 @@
+package app
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oraichain/orai/x/drip"
+	dripkeeper "github.com/oraichain/orai/x/drip/keeper"
+	driptypes "github.com/oraichain/orai/x/drip/types"
+)
+
+// ensure that only allowed addresses drip tokens, and that the drips reach
+// the fee collector at the next block
+func TestDrip(t *testing.T) {
+	gapp, ctx := setupWasmBindingApp(t)
+	msgServer := dripkeeper.NewMsgServerImpl(gapp.DripKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	treasury := sdk.AccAddress("treasury____________")
+	other := sdk.AccAddress("other_______________")
+	amount := sdk.NewCoins(sdk.NewInt64Coin("orai", 1000))
+	for _, addr := range []sdk.AccAddress{treasury, other} {
+		require.NoError(t, gapp.bankKeeper.MintCoins(ctx, minttypes.ModuleName, amount))
+		require.NoError(t, gapp.bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, amount))
+	}
+	gapp.DripKeeper.SetParams(ctx, driptypes.NewParams(true, []string{treasury.String()}))
+
+	_, err := msgServer.DistributeTokens(goCtx, driptypes.NewMsgDistributeTokens(other, amount))
+	require.ErrorIs(t, err, driptypes.ErrDripNotAllowed)
+	_, err = msgServer.DistributeTokens(goCtx, driptypes.NewMsgDistributeTokens(treasury, amount))
+	require.NoError(t, err)
+	require.True(t, gapp.bankKeeper.GetAllBalances(ctx, treasury).IsZero())
+
+	feeCollector := gapp.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	fees := gapp.bankKeeper.GetAllBalances(ctx, feeCollector)
+	drip.BeginBlocker(ctx, gapp.DripKeeper)
+	require.True(t, gapp.bankKeeper.GetAllBalances(ctx, gapp.accountKeeper.GetModuleAddress(driptypes.ModuleName)).IsZero())
+	require.Equal(t, fees.Add(amount...), gapp.bankKeeper.GetAllBalances(ctx, feeCollector))
+
+	gapp.DripKeeper.SetParams(ctx, driptypes.NewParams(false, []string{treasury.String()}))
+	_, err = msgServer.DistributeTokens(goCtx, driptypes.NewMsgDistributeTokens(other, amount))
+	require.ErrorIs(t, err, driptypes.ErrDripDisabled)
+}
@@ -0,0 +1,113 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/oraichain/orai/app/upgrades"
+)
+
+// UpgradeDryRun summarizes the state changes of an upgrade applied on top of
+// the latest committed height.
+type UpgradeDryRun struct {
+	UpgradeName      string                         `json:"upgrade_name"`
+	Height           int64                          `json:"height"`
+	Versions         []upgrades.ModuleVersionChange `json:"versions"`
+	Stores           []StoreSizeChange              `json:"stores"`
+	BrokenInvariants []string                       `json:"broken_invariants"`
+}
+
+// StoreSizeChange is the size of a store before and after an upgrade.
+type StoreSizeChange struct {
+	Store  string             `json:"store"`
+	Before upgrades.StoreSize `json:"before"`
+	After  upgrades.StoreSize `json:"after"`
+}
+
+// DryRunUpgrade loads the latest committed height with the store upgrades of
+// the named upgrade, then runs its handler and all crisis invariants on a
+// cached context at the next height. Nothing is committed. The app must be
+// created without loading the latest version from db.
+//
+// Stores added by the upgrade that already have data, as on chains started
+// after the upgrade was released, are loaded as they are.
+//
+// Loading the stores may still rewrite the database format, so the dry run
+// should be done on a copy of the node home.
+func (app *OraichainApp) DryRunUpgrade(db dbm.DB, name string, chainID string) (UpgradeDryRun, error) {
+	upgrade, found := findUpgrade(name)
+	if !found {
+		return UpgradeDryRun{}, fmt.Errorf("no upgrade named %s is registered", name)
+	}
+
+	version, committed, err := committedStores(db)
+	if err != nil {
+		return UpgradeDryRun{}, err
+	}
+	if version == 0 {
+		return UpgradeDryRun{}, fmt.Errorf("no committed height to upgrade")
+	}
+
+	storeUpgrades := upgrade.StoreUpgrades
+	storeUpgrades.Added = nil
+	for _, name := range upgrade.StoreUpgrades.Added {
+		if !committed[name] {
+			storeUpgrades.Added = append(storeUpgrades.Added, name)
+		}
+	}
+	app.SetStoreLoader(func(ms sdk.CommitMultiStore) error {
+		return ms.LoadLatestVersionAndUpgrade(&storeUpgrades)
+	})
+	if err := app.LoadLatestVersion(); err != nil {
+		return UpgradeDryRun{}, err
+	}
+
+	header := tmproto.Header{ChainID: chainID, Height: app.LastBlockHeight() + 1, Time: time.Now().UTC()}
+	ctx, _ := app.NewUncachedContext(false, header).CacheContext()
+
+	if doneHeight := app.upgradeKeeper.GetDoneHeight(ctx, name); doneHeight > 0 {
+		return UpgradeDryRun{}, fmt.Errorf("upgrade %s was already applied at height %d", name, doneHeight)
+	}
+
+	before := app.measureStores(ctx)
+
+	fromVM := app.upgradeKeeper.GetModuleVersionMap(ctx)
+	plan := upgradetypes.Plan{Name: name, Height: header.Height}
+	toVM, err := upgrade.CreateUpgradeHandler(app.mm, app.configurator, app.upgradeKeepers())(ctx, plan, fromVM)
+	if err != nil {
+		return UpgradeDryRun{}, fmt.Errorf("upgrade handler failed: %w", err)
+	}
+
+	report := UpgradeDryRun{
+		UpgradeName:      name,
+		Height:           header.Height,
+		Versions:         upgrades.DiffVersionMaps(fromVM, toVM),
+		BrokenInvariants: []string{},
+	}
+
+	for _, check := range app.checkInvariants(ctx) {
+		if check.Broken {
+			report.BrokenInvariants = append(report.BrokenInvariants, check.Message)
+		}
+	}
+
+	after := app.measureStores(ctx)
+	for _, name := range app.storeNames() {
+		report.Stores = append(report.Stores, StoreSizeChange{Store: name, Before: before[name], After: after[name]})
+	}
+
+	return report, nil
+}
+
+func (app *OraichainApp) measureStores(ctx sdk.Context) map[string]upgrades.StoreSize {
+	sizes := make(map[string]upgrades.StoreSize, len(app.keys))
+	for name, key := range app.keys {
+		sizes[name] = upgrades.MeasureStore(ctx.MultiStore().GetKVStore(key))
+	}
+	return sizes
+}
@@ -0,0 +1,103 @@
+package app
+
+import (
+	"os"
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oraichain/orai/app/walker"
+	"github.com/oraichain/orai/x/feeshare"
+	feeshareante "github.com/oraichain/orai/x/feeshare/ante"
+	feesharekeeper "github.com/oraichain/orai/x/feeshare/keeper"
+	feesharetypes "github.com/oraichain/orai/x/feeshare/types"
+)
+
+// ensure that only the admin registers a contract, that the fees of txs
+// executing it are shared with its withdrawer, wrapped executions included,
+// and that the fee shares survive a genesis export and import
+func TestFeeShare(t *testing.T) {
+	gapp, ctx := setupWasmBindingApp(t)
+	msgServer := feesharekeeper.NewMsgServerImpl(gapp.FeeShareKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	admin := sdk.AccAddress("admin_______________")
+	other := sdk.AccAddress("other_______________")
+	withdrawer := sdk.AccAddress("withdrawer__________")
+
+	code, err := os.ReadFile("./bytecode/reflect.wasm")
+	require.NoError(t, err)
+	codeID, _, err := gapp.ContractKeeper.Create(ctx, admin, code, nil)
+	require.NoError(t, err)
+	contract, _, err := gapp.ContractKeeper.Instantiate(ctx, codeID, admin, admin, []byte(`{}`), "reflect", nil)
+	require.NoError(t, err)
+
+	_, err = msgServer.RegisterFeeShare(goCtx, feesharetypes.NewMsgRegisterFeeShare(contract, other, withdrawer))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.RegisterFeeShare(goCtx, feesharetypes.NewMsgRegisterFeeShare(other, admin, withdrawer))
+	require.ErrorIs(t, err, feesharetypes.ErrContractNotFound)
+	_, err = msgServer.RegisterFeeShare(goCtx, feesharetypes.NewMsgRegisterFeeShare(contract, admin, gapp.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName)))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.RegisterFeeShare(goCtx, feesharetypes.NewMsgRegisterFeeShare(contract, admin, other))
+	require.NoError(t, err)
+	_, err = msgServer.RegisterFeeShare(goCtx, feesharetypes.NewMsgRegisterFeeShare(contract, admin, other))
+	require.ErrorIs(t, err, feesharetypes.ErrFeeShareAlreadyExists)
+
+	// only the deployer changes the withdrawer, which moves the index
+	_, err = msgServer.UpdateFeeShare(goCtx, feesharetypes.NewMsgUpdateFeeShare(contract, other, withdrawer))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.UpdateFeeShare(goCtx, feesharetypes.NewMsgUpdateFeeShare(contract, admin, withdrawer))
+	require.NoError(t, err)
+
+	querier := feesharekeeper.NewQuerier(gapp.FeeShareKeeper)
+	deployerRes, err := querier.DeployerFeeShares(goCtx, &feesharetypes.QueryDeployerFeeSharesRequest{DeployerAddress: admin.String()})
+	require.NoError(t, err)
+	require.Equal(t, []string{contract.String()}, deployerRes.ContractAddresses)
+	withdrawerRes, err := querier.WithdrawerFeeShares(goCtx, &feesharetypes.QueryWithdrawerFeeSharesRequest{WithdrawerAddress: other.String()})
+	require.NoError(t, err)
+	require.Empty(t, withdrawerRes.ContractAddresses)
+	withdrawerRes, err = querier.WithdrawerFeeShares(goCtx, &feesharetypes.QueryWithdrawerFeeSharesRequest{WithdrawerAddress: withdrawer.String()})
+	require.NoError(t, err)
+	require.Equal(t, []string{contract.String()}, withdrawerRes.ContractAddresses)
+
+	// the fees are deducted into the fee collector before the decorator runs
+	fee := sdk.NewCoins(sdk.NewInt64Coin("orai", 1000))
+	require.NoError(t, gapp.bankKeeper.MintCoins(ctx, minttypes.ModuleName, fee))
+	require.NoError(t, gapp.bankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, authtypes.FeeCollectorName, fee))
+
+	execMsg := &wasmtypes.MsgExecuteContract{Sender: other.String(), Contract: contract.String(), Msg: []byte(`{}`)}
+	execOther := &wasmtypes.MsgExecuteContract{Sender: other.String(), Contract: other.String(), Msg: []byte(`{}`)}
+	authzMsg := authz.NewMsgExec(admin, []sdk.Msg{execMsg})
+	txBuilder := MakeEncodingConfig().TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(execMsg, execOther, execMsg, &authzMsg))
+	txBuilder.SetFeeAmount(fee)
+
+	decorator := feeshareante.NewDistributeFeeShareDecorator(walker.New(gapp.appCodec, walker.DefaultMaxDepth), gapp.FeeShareKeeper)
+	_, err = decorator.AnteHandle(ctx, txBuilder.GetTx(), false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		return ctx, nil
+	})
+	require.NoError(t, err)
+
+	// half of the fees split over the three executions of the contract
+	require.Equal(t, sdk.NewInt64Coin("orai", 498), gapp.bankKeeper.GetBalance(ctx, withdrawer, "orai"))
+	require.Equal(t, sdk.NewInt64Coin("orai", 502), gapp.bankKeeper.GetBalance(ctx, gapp.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName), "orai"))
+
+	genesis := feeshare.ExportGenesis(ctx, gapp.FeeShareKeeper)
+	require.Len(t, genesis.FeeShares, 1)
+
+	_, err = msgServer.CancelFeeShare(goCtx, feesharetypes.NewMsgCancelFeeShare(contract, other))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.CancelFeeShare(goCtx, feesharetypes.NewMsgCancelFeeShare(contract, admin))
+	require.NoError(t, err)
+	_, found := gapp.FeeShareKeeper.GetFeeShare(ctx, contract)
+	require.False(t, found)
+
+	feeshare.InitGenesis(ctx, gapp.FeeShareKeeper, *genesis)
+	require.Equal(t, genesis, feeshare.ExportGenesis(ctx, gapp.FeeShareKeeper))
+}
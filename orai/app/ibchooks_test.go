@@ -0,0 +1,171 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oraichain/orai/app/wasmhooks"
+)
+
+// newTransferPath opens a transfer channel between the first two chains of
+// the coordinator.
+func newTransferPath(coordinator *ibctesting.Coordinator) *ibctesting.Path {
+	path := ibctesting.NewPath(coordinator.GetChain(ibctesting.GetChainID(1)), coordinator.GetChain(ibctesting.GetChainID(2)))
+	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	path.EndpointB.ChannelConfig.Version = transfertypes.Version
+	coordinator.Setup(path)
+	return path
+}
+
+// sendTransfer sends tokens of the sender through the channel of the
+// endpoint without signing a tx, so that the sender can be a contract.
+func sendTransfer(endpoint *ibctesting.Endpoint, sender sdk.AccAddress, receiver string, token sdk.Coin, memo string, timeoutHeight clienttypes.Height, timeoutTimestamp uint64) (channeltypes.Packet, error) {
+	chain := endpoint.Chain
+	// failed transfers must not escrow the tokens, as in a tx
+	ctx, write := chain.GetContext().CacheContext()
+	msg := transfertypes.NewMsgTransfer(
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID,
+		token, sender.String(), receiver,
+		timeoutHeight, timeoutTimestamp,
+	)
+	msg.Memo = memo
+	if _, err := oraichainApp(chain).transferKeeper.Transfer(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return channeltypes.Packet{}, err
+	}
+	write()
+	chain.Coordinator.CommitBlock(chain)
+	return ibctesting.ParsePacketFromEvents(ctx.EventManager().Events())
+}
+
+func hasEvent(events sdk.Events, eventType string) bool {
+	for _, event := range events {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// ensure that the ibc_callback contract of an ics20 transfer is called back
+// once the transfer completes, without a failing callback failing its ack or
+// timeout
+func TestIBCCallback(t *testing.T) {
+	ibctesting.DefaultTestingAppInit = setupIBCTestingApp(t)
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	path := newTransferPath(coordinator)
+	chainA, chainB := path.EndpointA.Chain, path.EndpointB.Chain
+
+	appA := oraichainApp(chainA)
+	ctx := chainA.GetContext()
+	creator := chainA.SenderAccount.GetAddress()
+	receiver := chainB.SenderAccount.GetAddress().String()
+
+	instantiate := func(file string, initMsg []byte) sdk.AccAddress {
+		code, err := os.ReadFile(file)
+		require.NoError(t, err)
+		codeID, _, err := appA.ContractKeeper.Create(ctx, creator, code, nil)
+		require.NoError(t, err)
+		contract, _, err := appA.ContractKeeper.Instantiate(ctx, codeID, creator, nil, initMsg, file, nil)
+		require.NoError(t, err)
+		require.NoError(t, appA.bankKeeper.SendCoins(ctx, creator, contract, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))))
+		return contract
+	}
+	// the recorder contract stores the last sudo msg it got under "last",
+	// while the counter contract has no sudo entry point, so its callbacks fail
+	recorder := instantiate("bytecode/ibc_callback.wasm", []byte(`{}`))
+	counter := instantiate("../../interchaintest/contracts/counter.wasm", []byte(`{"count":0}`))
+	coordinator.CommitBlock(chainA)
+
+	transfer := func(contract sdk.AccAddress, receiver string, timeoutHeight clienttypes.Height, timeoutTimestamp uint64) channeltypes.Packet {
+		memo := fmt.Sprintf(`{"ibc_callback":"%s"}`, contract)
+		packet, err := sendTransfer(path.EndpointA, contract, receiver, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), memo, timeoutHeight, timeoutTimestamp)
+		require.NoError(t, err)
+		require.Equal(t, contract.String(), callback(appA, chainA, packet))
+		return packet
+	}
+	timeout := func() uint64 { return uint64(chainB.CurrentHeader.Time.Add(time.Hour).UnixNano()) }
+	acknowledge := func(packet channeltypes.Packet) (ack []byte, events sdk.Events) {
+		require.NoError(t, path.EndpointB.UpdateClient())
+		res, err := path.EndpointB.RecvPacketWithResult(packet)
+		require.NoError(t, err)
+		ack, err = ibctesting.ParseAckFromEvents(res.GetEvents())
+		require.NoError(t, err)
+
+		require.NoError(t, path.EndpointA.UpdateClient())
+		proof, proofHeight := path.EndpointB.QueryProof(host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+		res, err = chainA.SendMsgs(channeltypes.NewMsgAcknowledgement(packet, ack, proof, proofHeight, creator.String()))
+		require.NoError(t, err)
+		require.Empty(t, callback(appA, chainA, packet))
+		return ack, res.GetEvents()
+	}
+	recorded := func() wasmhooks.IBCLifecycleComplete {
+		var msg wasmhooks.SudoMsg
+		require.NoError(t, json.Unmarshal(appA.wasmKeeper.QueryRaw(chainA.GetContext(), recorder, []byte("last")), &msg))
+		require.NotNil(t, msg.IBCLifecycleComplete)
+		return *msg.IBCLifecycleComplete
+	}
+
+	t.Run("callback of another account", func(t *testing.T) {
+		memo := fmt.Sprintf(`{"ibc_callback":"%s"}`, recorder)
+		_, err := sendTransfer(path.EndpointA, creator, receiver, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), memo, clienttypes.ZeroHeight(), timeout())
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	})
+
+	t.Run("success ack", func(t *testing.T) {
+		packet := transfer(recorder, receiver, clienttypes.ZeroHeight(), timeout())
+
+		// the callback is not sent to the counterparty
+		var data transfertypes.FungibleTokenPacketData
+		require.NoError(t, transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data))
+		require.Empty(t, data.Memo)
+
+		ack, _ := acknowledge(packet)
+		require.Equal(t, wasmhooks.IBCLifecycleComplete{
+			IBCAck: &wasmhooks.IBCAck{Channel: packet.SourceChannel, Sequence: packet.Sequence, Ack: ack, Success: true},
+		}, recorded())
+	})
+
+	t.Run("error ack", func(t *testing.T) {
+		// the counterparty cannot credit an invalid receiver
+		packet := transfer(recorder, "invalid", clienttypes.ZeroHeight(), timeout())
+		ack, _ := acknowledge(packet)
+		require.Equal(t, wasmhooks.IBCLifecycleComplete{
+			IBCAck: &wasmhooks.IBCAck{Channel: packet.SourceChannel, Sequence: packet.Sequence, Ack: ack, Success: false},
+		}, recorded())
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		packet := transfer(recorder, receiver, clienttypes.GetSelfHeight(chainB.GetContext()), 0)
+		coordinator.CommitBlock(chainB)
+		require.NoError(t, path.EndpointA.UpdateClient())
+		require.NoError(t, path.EndpointA.TimeoutPacket(packet))
+		require.Empty(t, callback(appA, chainA, packet))
+		require.Equal(t, wasmhooks.IBCLifecycleComplete{
+			IBCTimeout: &wasmhooks.IBCTimeout{Channel: packet.SourceChannel, Sequence: packet.Sequence},
+		}, recorded())
+	})
+
+	t.Run("failing callback", func(t *testing.T) {
+		packet := transfer(counter, receiver, clienttypes.ZeroHeight(), timeout())
+		_, events := acknowledge(packet)
+		require.True(t, hasEvent(events, wasmhooks.EventTypeAckCallbackError))
+	})
+}
+
+func callback(app *OraichainApp, chain *ibctesting.TestChain, packet channeltypes.Packet) string {
+	return app.IBCHooksKeeper.GetPacketCallback(chain.GetContext(), packet.SourceChannel, packet.Sequence)
+}
@@ -0,0 +1,133 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/CosmWasm/wasmd/x/wasm"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	ibckeeper "github.com/cosmos/ibc-go/v4/modules/core/keeper"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	db "github.com/tendermint/tm-db"
+
+	icqtypes "github.com/oraichain/orai/x/icq/types"
+)
+
+// ibcTestingApp adapts OraichainApp to the ibc-go testing framework.
+type ibcTestingApp struct {
+	*OraichainApp
+	txConfig client.TxConfig
+}
+
+var _ ibctesting.TestingApp = ibcTestingApp{}
+
+func (app ibcTestingApp) GetBaseApp() *baseapp.BaseApp           { return app.BaseApp }
+func (app ibcTestingApp) GetStakingKeeper() stakingkeeper.Keeper { return app.stakingKeeper }
+func (app ibcTestingApp) GetIBCKeeper() *ibckeeper.Keeper        { return app.ibcKeeper }
+func (app ibcTestingApp) GetScopedIBCKeeper() capabilitykeeper.ScopedKeeper {
+	return app.scopedIBCKeeper
+}
+func (app ibcTestingApp) GetTxConfig() client.TxConfig { return app.txConfig }
+func (app ibcTestingApp) AppCodec() codec.Codec        { return app.appCodec }
+
+// setupIBCTestingApp returns the app init of the testing framework, every
+// chain getting its own home in a temporary directory of the test.
+func setupIBCTestingApp(t *testing.T) func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	return func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+		encodingConfig := MakeEncodingConfig()
+		app := NewOraichainApp(log.NewNopLogger(), db.NewMemDB(), nil, true, map[int64]bool{}, t.TempDir(), 0, encodingConfig, wasm.EnableAllProposals, EmptyAppOptions{}, emptyWasmOpts)
+		genesisState := NewDefaultGenesisState(encodingConfig.Codec)
+
+		// the light clients of the testing framework expect its unbonding period
+		var stakingGenesis stakingtypes.GenesisState
+		encodingConfig.Codec.MustUnmarshalJSON(genesisState[stakingtypes.ModuleName], &stakingGenesis)
+		stakingGenesis.Params.UnbondingTime = ibctesting.UnbondingPeriod
+		genesisState[stakingtypes.ModuleName] = encodingConfig.Codec.MustMarshalJSON(&stakingGenesis)
+
+		return ibcTestingApp{OraichainApp: app, txConfig: encodingConfig.TxConfig}, genesisState
+	}
+}
+
+func oraichainApp(chain *ibctesting.TestChain) *OraichainApp {
+	return chain.App.(ibcTestingApp).OraichainApp
+}
+
+// ensure that a chain queries another one through an icq channel
+func TestInterchainQuery(t *testing.T) {
+	ibctesting.DefaultTestingAppInit = setupIBCTestingApp(t)
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	controller := coordinator.GetChain(ibctesting.GetChainID(1))
+	host := coordinator.GetChain(ibctesting.GetChainID(2))
+
+	path := ibctesting.NewPath(controller, host)
+	path.EndpointA.ChannelConfig.PortID = icqtypes.ControllerPortID
+	path.EndpointB.ChannelConfig.PortID = icqtypes.HostPortID
+	path.EndpointA.ChannelConfig.Version = icqtypes.Version
+	path.EndpointB.ChannelConfig.Version = icqtypes.Version
+	path.EndpointA.ChannelConfig.Order = channeltypes.UNORDERED
+	path.EndpointB.ChannelConfig.Order = channeltypes.UNORDERED
+	coordinator.Setup(path)
+
+	allBalancesPath := "/cosmos.bank.v1beta1.Query/AllBalances"
+	hostApp := oraichainApp(host)
+	hostApp.ICQKeeper.SetParams(host.GetContext(), icqtypes.NewParams(true, []string{allBalancesPath}, icqtypes.DefaultCallbackGasLimit))
+	coordinator.CommitBlock(host)
+	require.NoError(t, path.EndpointA.UpdateClient())
+
+	balancesReq, err := (&banktypes.QueryAllBalancesRequest{Address: host.SenderAccount.GetAddress().String()}).Marshal()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		path    string
+		wantErr bool
+	}{
+		{"allowed query", allBalancesPath, false},
+		{"query not allowed", "/cosmos.bank.v1beta1.Query/TotalSupply", true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := icqtypes.NewMsgSendQuery(controller.SenderAccount.GetAddress(), path.EndpointA.ChannelID, []icqtypes.QueryRequest{{Path: tc.path, Data: balancesReq}}, time.Hour)
+			res, err := controller.SendMsgs(msg)
+			require.NoError(t, err)
+
+			packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+			require.NoError(t, err)
+
+			require.NoError(t, path.EndpointB.UpdateClient())
+			res, err = path.EndpointB.RecvPacketWithResult(packet)
+			require.NoError(t, err)
+			ackBz, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+			require.NoError(t, err)
+			require.NoError(t, path.EndpointA.AcknowledgePacket(packet, ackBz))
+
+			var ack channeltypes.Acknowledgement
+			require.NoError(t, channeltypes.SubModuleCdc.UnmarshalJSON(ackBz, &ack))
+			require.Equal(t, tc.wantErr, !ack.Success())
+			if tc.wantErr {
+				return
+			}
+
+			var packetAck icqtypes.InterchainQueryPacketAck
+			require.NoError(t, icqtypes.ModuleCdc.UnmarshalJSON(ack.GetResult(), &packetAck))
+			resps, err := icqtypes.DeserializeCosmosResponse(packetAck.Data)
+			require.NoError(t, err)
+			require.Len(t, resps, 1)
+
+			var balances banktypes.QueryAllBalancesResponse
+			require.NoError(t, balances.Unmarshal(resps[0].Value))
+			require.Equal(t, hostApp.bankKeeper.GetAllBalances(host.GetContext(), host.SenderAccount.GetAddress()), balances.Balances)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	commissiontypes "github.com/oraichain/orai/x/commission/types"
+	feesharetypes "github.com/oraichain/orai/x/feeshare/types"
+	feetokentypes "github.com/oraichain/orai/x/feetoken/types"
+	globalfeetypes "github.com/oraichain/orai/x/globalfee/types"
+	msgfiltertypes "github.com/oraichain/orai/x/msgfilter/types"
+	msglimittypes "github.com/oraichain/orai/x/msglimit/types"
+	sponsortypes "github.com/oraichain/orai/x/sponsor/types"
+)
+
+// anteModules are the modules whose params or state the ante handler reads
+// on every tx. They are registered in any module subset, so that their
+// genesis is initialized and the app can deliver txs.
+var anteModules = []string{
+	authtypes.ModuleName,
+	banktypes.ModuleName,
+	paramstypes.ModuleName,
+	commissiontypes.ModuleName,
+	globalfeetypes.ModuleName,
+	feetokentypes.ModuleName,
+	sponsortypes.ModuleName,
+	msglimittypes.ModuleName,
+	msgfiltertypes.ModuleName,
+	feesharetypes.ModuleName,
+}
+
+// moduleSubset lists the modules registered in the module manager, all of
+// them when empty, along with the ante modules. Keepers and stores are kept
+// for every module.
+//
+// A subset changes the state machine, so it is not read from the app options
+// and only set by tests, to make their apps lighter.
+type moduleSubset []string
+
+// validate checks that the listed modules are modules of the app, listed once.
+func (s moduleSubset) validate() error {
+	listed := map[string]bool{}
+	for _, name := range s {
+		if _, ok := ModuleBasics[name]; !ok {
+			return fmt.Errorf("unknown module %s", name)
+		}
+		if listed[name] {
+			return fmt.Errorf("module %s listed twice", name)
+		}
+		listed[name] = true
+	}
+	return nil
+}
+
+// isEnabled reports whether the module is registered in the module manager.
+func (s moduleSubset) isEnabled(name string) bool {
+	if len(s) == 0 {
+		return true
+	}
+	for _, enabled := range anteModules {
+		if enabled == name {
+			return true
+		}
+	}
+	for _, enabled := range s {
+		if enabled == name {
+			return true
+		}
+	}
+	return false
+}
+
+// appModules filters out the disabled modules.
+func (s moduleSubset) appModules(modules ...module.AppModule) []module.AppModule {
+	enabled := make([]module.AppModule, 0, len(modules))
+	for _, m := range modules {
+		if s.isEnabled(m.Name()) {
+			enabled = append(enabled, m)
+		}
+	}
+	return enabled
+}
+
+// moduleNames filters out the names of the disabled modules, keeping the
+// order.
+func (s moduleSubset) moduleNames(names ...string) []string {
+	enabled := make([]string, 0, len(names))
+	for _, name := range names {
+		if s.isEnabled(name) {
+			enabled = append(enabled, name)
+		}
+	}
+	return enabled
+}
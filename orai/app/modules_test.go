@@ -0,0 +1,66 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestModuleSubsetValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modules moduleSubset
+		wantErr string
+	}{
+		{"all modules", nil, ""},
+		{"modules", moduleSubset{authtypes.ModuleName, banktypes.ModuleName}, ""},
+		{"unknown module", moduleSubset{"unknown"}, "unknown module unknown"},
+		{"duplicate module", moduleSubset{banktypes.ModuleName, banktypes.ModuleName}, "module bank listed twice"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.modules.validate()
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+// ensure that an app restricted to a subset of its modules delivers txs
+func TestAppWithModuleSubset(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	sender := simTestAccount{privKeys: []cryptotypes.PrivKey{privKey}, pubKey: privKey.PubKey()}
+	recipient := sdk.AccAddress("recipient___________")
+
+	// the ante modules are registered along with the listed ones
+	modules := moduleSubset{capabilitytypes.ModuleName, upgradetypes.ModuleName}
+	gapp := setupSimTestAppWithModules(t, modules, sender)
+	require.ElementsMatch(t, append(anteModules, modules...), gapp.mm.ModuleNames())
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin("orai", 1000))
+	txBytes := buildSimTestTx(t, gapp, sender, []sdk.Msg{banktypes.NewMsgSend(sender.address(), recipient, amount)}, false)
+
+	header := tmproto.Header{ChainID: simTestChainID, Height: gapp.LastBlockHeight() + 1, Time: time.Now()}
+	gapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	res := gapp.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), res.Log)
+	gapp.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	gapp.Commit()
+
+	ctx := gapp.NewContext(true, tmproto.Header{})
+	require.Equal(t, amount, gapp.bankKeeper.GetAllBalances(ctx, recipient))
+}
@@ -0,0 +1,103 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	icahosttypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+	"github.com/stretchr/testify/require"
+
+	msgfiltertypes "github.com/oraichain/orai/x/msgfilter/types"
+)
+
+// ensure that the disabled msgs of an interchain account packet get an error
+// ack, rather than failing the tx relaying the packet
+func TestMsgFilterICAHost(t *testing.T) {
+	ibctesting.DefaultTestingAppInit = setupIBCTestingApp(t)
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	controller := coordinator.GetChain(ibctesting.GetChainID(1))
+	host := coordinator.GetChain(ibctesting.GetChainID(2))
+	controllerApp, hostApp := oraichainApp(controller), oraichainApp(host)
+
+	version := string(icatypes.ModuleCdc.MustMarshalJSON(&icatypes.Metadata{
+		Version:                icatypes.Version,
+		ControllerConnectionId: ibctesting.FirstConnectionID,
+		HostConnectionId:       ibctesting.FirstConnectionID,
+		Encoding:               icatypes.EncodingProtobuf,
+		TxType:                 icatypes.TxTypeSDKMultiMsg,
+	}))
+	path := ibctesting.NewPath(controller, host)
+	path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED
+	path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
+	path.EndpointA.ChannelConfig.Version = version
+	path.EndpointB.ChannelConfig.Version = version
+	path.EndpointB.ChannelConfig.PortID = icatypes.PortID
+	coordinator.SetupConnections(path)
+
+	owner := controller.SenderAccount.GetAddress().String()
+	channelSequence := controllerApp.ibcKeeper.ChannelKeeper.GetNextChannelSequence(controller.GetContext())
+	portID, err := controllerApp.ICAAuthKeeper.RegisterAccount(controller.GetContext(), owner, path.EndpointA.ConnectionID, version)
+	require.NoError(t, err)
+	controller.NextBlock()
+	path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(channelSequence)
+	path.EndpointA.ChannelConfig.PortID = portID
+	require.NoError(t, path.EndpointB.ChanOpenTry())
+	require.NoError(t, path.EndpointA.ChanOpenAck())
+	require.NoError(t, path.EndpointB.ChanOpenConfirm())
+
+	icaAddr, err := controllerApp.ICAAuthKeeper.GetInterchainAccountAddress(controller.GetContext(), owner, path.EndpointA.ConnectionID)
+	require.NoError(t, err)
+	ica := sdk.MustAccAddressFromBech32(icaAddr)
+	recipient := host.SenderAccount.GetAddress()
+	send := banktypes.NewMsgSend(ica, recipient, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+
+	ctx := host.GetContext()
+	hostApp.icaHostKeeper.SetParams(ctx, icahosttypes.NewParams(true, []string{sdk.MsgTypeURL(send)}))
+	require.NoError(t, hostApp.bankKeeper.SendCoins(ctx, recipient, ica, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))))
+	hostApp.MsgFilterKeeper.DisableMsg(ctx, sdk.MsgTypeURL(send))
+	coordinator.CommitBlock(host)
+
+	relay := func() channeltypes.Acknowledgement {
+		anySend, err := codectypes.NewAnyWithValue(send)
+		require.NoError(t, err)
+		ctx := controller.GetContext()
+		_, _, err = controllerApp.ICAAuthKeeper.SubmitTx(ctx, owner, path.EndpointA.ConnectionID, []*codectypes.Any{anySend}, "", uint64(host.CurrentHeader.Time.Add(time.Hour).UnixNano()))
+		require.NoError(t, err)
+		packet, err := ibctesting.ParsePacketFromEvents(ctx.EventManager().Events())
+		require.NoError(t, err)
+		coordinator.CommitBlock(controller)
+
+		// the relay tx goes through the ante handler of the host
+		require.NoError(t, path.EndpointB.UpdateClient())
+		res, err := path.EndpointB.RecvPacketWithResult(packet)
+		require.NoError(t, err)
+		ackBz, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+		require.NoError(t, err)
+		var ack channeltypes.Acknowledgement
+		require.NoError(t, channeltypes.SubModuleCdc.UnmarshalJSON(ackBz, &ack))
+		return ack
+	}
+	balance := func() sdk.Int {
+		return hostApp.bankKeeper.GetBalance(host.GetContext(), ica, sdk.DefaultBondDenom).Amount
+	}
+
+	require.False(t, relay().Success())
+	require.Equal(t, sdk.NewInt(10), balance())
+
+	// msgs executed by authz are filtered too
+	exec := authz.NewMsgExec(ica, []sdk.Msg{send})
+	_, err = hostApp.MsgServiceRouter().Handler(&exec)(host.GetContext(), &exec)
+	require.ErrorIs(t, err, msgfiltertypes.ErrMsgDisabled)
+
+	hostApp.MsgFilterKeeper.EnableMsg(host.GetContext(), sdk.MsgTypeURL(send))
+	coordinator.CommitBlock(host)
+	require.True(t, relay().Success())
+	require.Equal(t, sdk.NewInt(9), balance())
+}
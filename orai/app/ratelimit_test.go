@@ -0,0 +1,117 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+	"github.com/stretchr/testify/require"
+
+	ratelimitkeeper "github.com/oraichain/orai/x/ratelimit/keeper"
+	ratelimittypes "github.com/oraichain/orai/x/ratelimit/types"
+)
+
+// ensure that the transfers of a rate limited path are rejected once their
+// net flow exceeds the quota of the window
+func TestRateLimit(t *testing.T) {
+	ibctesting.DefaultTestingAppInit = setupIBCTestingApp(t)
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	path := newTransferPath(coordinator)
+	chainA, chainB := path.EndpointA.Chain, path.EndpointB.Chain
+
+	appA := oraichainApp(chainA)
+	handler := ratelimitkeeper.NewProposalHandler(appA.RateLimitKeeper)
+	denom := sdk.DefaultBondDenom
+	channelID := path.EndpointA.ChannelID
+	quota := ratelimittypes.NewQuota(sdk.NewInt(2), sdk.NewInt(1), 24)
+
+	ctx := chainA.GetContext()
+	err := handler(ctx, ratelimittypes.NewAddRateLimitProposal("title", "description", ratelimittypes.NewPath(denom, "channel-9"), quota))
+	require.ErrorIs(t, err, ratelimittypes.ErrChannelNotFound)
+	err = handler(ctx, ratelimittypes.NewAddRateLimitProposal("title", "description", ratelimittypes.NewPath("unknown", channelID), quota))
+	require.ErrorIs(t, err, ratelimittypes.ErrZeroChannelValue)
+	err = handler(ctx, ratelimittypes.NewAddRateLimitProposal("title", "description", ratelimittypes.NewPath(denom, channelID), quota))
+	require.NoError(t, err)
+	err = handler(ctx, ratelimittypes.NewAddRateLimitProposal("title", "description", ratelimittypes.NewPath(denom, channelID), quota))
+	require.ErrorIs(t, err, ratelimittypes.ErrRateLimitAlreadyExists)
+	coordinator.CommitBlock(chainA)
+
+	flow := func() ratelimittypes.Flow {
+		rateLimit, found := appA.RateLimitKeeper.GetRateLimit(chainA.GetContext(), denom, channelID)
+		require.True(t, found)
+		return rateLimit.Flow
+	}
+	maxSend := flow().ChannelValue.MulRaw(2).QuoRaw(100)
+	maxRecv := flow().ChannelValue.QuoRaw(100)
+	timeout := func() uint64 { return uint64(chainB.CurrentHeader.Time.Add(time.Hour).UnixNano()) }
+	sender := chainA.SenderAccount.GetAddress()
+	receiverA, receiverB := sender.String(), chainB.SenderAccount.GetAddress().String()
+
+	// exceeding sends are rejected
+	_, err = sendTransfer(path.EndpointA, sender, receiverB, sdk.NewCoin(denom, maxSend.AddRaw(1)), "", clienttypes.ZeroHeight(), timeout())
+	require.ErrorIs(t, err, ratelimittypes.ErrQuotaExceeded)
+	require.True(t, flow().Outflow.IsZero())
+
+	// the outflow of timed out sends is undone
+	packet, err := sendTransfer(path.EndpointA, sender, receiverB, sdk.NewCoin(denom, maxSend), "", clienttypes.GetSelfHeight(chainB.GetContext()), 0)
+	require.NoError(t, err)
+	require.Equal(t, maxSend, flow().Outflow)
+	require.True(t, appA.RateLimitKeeper.HasPendingSendPacket(chainA.GetContext(), denom, channelID, packet.Sequence))
+	coordinator.CommitBlock(chainB)
+	require.NoError(t, path.EndpointA.UpdateClient())
+	require.NoError(t, path.EndpointA.TimeoutPacket(packet))
+	require.True(t, flow().Outflow.IsZero())
+	require.False(t, appA.RateLimitKeeper.HasPendingSendPacket(chainA.GetContext(), denom, channelID, packet.Sequence))
+
+	// the outflow of received sends is kept
+	packet, err = sendTransfer(path.EndpointA, sender, receiverB, sdk.NewCoin(denom, maxSend), "", clienttypes.ZeroHeight(), timeout())
+	require.NoError(t, err)
+	require.NoError(t, path.RelayPacket(packet))
+	require.Equal(t, maxSend, flow().Outflow)
+	require.Empty(t, appA.RateLimitKeeper.GetAllPendingSendPackets(chainA.GetContext()))
+	_, err = sendTransfer(path.EndpointA, sender, receiverB, sdk.NewInt64Coin(denom, 1), "", clienttypes.ZeroHeight(), timeout())
+	require.ErrorIs(t, err, ratelimittypes.ErrQuotaExceeded)
+
+	// the flow is reset once the window is over
+	coordinator.IncrementTimeBy(quota.Duration())
+	coordinator.CommitBlock(chainA)
+	require.True(t, flow().Outflow.IsZero())
+
+	// exceeding receives are refused, the tokens returning to the sender
+	require.NoError(t, path.EndpointB.UpdateClient())
+	voucher := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, denom)).IBCDenom()
+	packet, err = sendTransfer(path.EndpointB, chainB.SenderAccount.GetAddress(), receiverA, sdk.NewCoin(voucher, maxRecv.AddRaw(1)), "", clienttypes.ZeroHeight(), timeout())
+	require.NoError(t, err)
+	require.NoError(t, path.EndpointA.UpdateClient())
+	res, err := path.EndpointA.RecvPacketWithResult(packet)
+	require.NoError(t, err)
+	ackBz, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	require.NoError(t, err)
+	var ack channeltypes.Acknowledgement
+	require.NoError(t, channeltypes.SubModuleCdc.UnmarshalJSON(ackBz, &ack))
+	require.False(t, ack.Success())
+	require.True(t, flow().Inflow.IsZero())
+	require.NoError(t, path.EndpointB.AcknowledgePacket(packet, ackBz))
+
+	packet, err = sendTransfer(path.EndpointB, chainB.SenderAccount.GetAddress(), receiverA, sdk.NewCoin(voucher, maxRecv), "", clienttypes.ZeroHeight(), timeout())
+	require.NoError(t, err)
+	require.NoError(t, path.RelayPacket(packet))
+	require.Equal(t, maxRecv, flow().Inflow)
+
+	// gov resets, updates and removes the rate limit
+	ctx = chainA.GetContext()
+	require.NoError(t, handler(ctx, ratelimittypes.NewResetRateLimitProposal("title", "description", ratelimittypes.NewPath(denom, channelID))))
+	require.True(t, flow().Inflow.IsZero())
+	require.NoError(t, handler(ctx, ratelimittypes.NewUpdateRateLimitProposal("title", "description", ratelimittypes.NewPath(denom, channelID), ratelimittypes.NewQuota(sdk.NewInt(100), sdk.NewInt(100), 1))))
+	rateLimit, _ := appA.RateLimitKeeper.GetRateLimit(ctx, denom, channelID)
+	require.Equal(t, uint64(1), rateLimit.Quota.DurationHours)
+	require.NoError(t, handler(ctx, ratelimittypes.NewRemoveRateLimitProposal("title", "description", ratelimittypes.NewPath(denom, channelID))))
+	_, found := appA.RateLimitKeeper.GetRateLimit(ctx, denom, channelID)
+	require.False(t, found)
+	err = handler(ctx, ratelimittypes.NewResetRateLimitProposal("title", "description", ratelimittypes.NewPath(denom, channelID)))
+	require.ErrorIs(t, err, ratelimittypes.ErrRateLimitNotFound)
+}
@@ -0,0 +1,82 @@
+package relayguard
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+)
+
+// RelayGuardDecorator rejects relay txs in CheckTx from signers whose relay
+// txs kept failing or being redundant in the current block. It must come
+// right before ibcante.NewAnteDecorator and after the signature verification,
+// so that it only counts relay errors against signers that actually signed
+// the tx. DeliverTx and simulations are not affected.
+type RelayGuardDecorator struct {
+	tracker *Tracker
+}
+
+func NewRelayGuardDecorator(tracker *Tracker) RelayGuardDecorator {
+	return RelayGuardDecorator{
+		tracker: tracker,
+	}
+}
+
+func (rgd RelayGuardDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if simulate || !(ctx.IsCheckTx() || ctx.IsReCheckTx()) || !IsRelayTx(tx.GetMsgs()) {
+		return next(ctx, tx, simulate)
+	}
+
+	signers := relaySigners(tx.GetMsgs())
+	for _, signer := range signers {
+		if rgd.tracker.IsRejected(signer) {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "relayer %s has too many failed relay txs, try again later", signer)
+		}
+	}
+
+	newCtx, err = next(ctx, tx, simulate)
+	if err != nil {
+		redundant := errors.Is(err, channeltypes.ErrRedundantTx)
+		for _, signer := range signers {
+			rgd.tracker.RecordFailure(signer, redundant)
+		}
+	}
+
+	return newCtx, err
+}
+
+// IsRelayTx returns true if the tx only contains packet and client update
+// msgs, as sent by relayers.
+func IsRelayTx(msgs []sdk.Msg) bool {
+	if len(msgs) == 0 {
+		return false
+	}
+
+	for _, msg := range msgs {
+		switch msg.(type) {
+		case *channeltypes.MsgRecvPacket, *channeltypes.MsgAcknowledgement,
+			*channeltypes.MsgTimeout, *channeltypes.MsgTimeoutOnClose,
+			*clienttypes.MsgUpdateClient:
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+func relaySigners(msgs []sdk.Msg) []sdk.AccAddress {
+	seen := make(map[string]bool)
+	var signers []sdk.AccAddress
+	for _, msg := range msgs {
+		for _, signer := range msg.GetSigners() {
+			if !seen[signer.String()] {
+				seen[signer.String()] = true
+				signers = append(signers, signer)
+			}
+		}
+	}
+	return signers
+}
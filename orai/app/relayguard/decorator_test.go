@@ -0,0 +1,115 @@
+package relayguard_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/oraichain/orai/app/relayguard"
+)
+
+type mockTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx mockTx) GetMsgs() []sdk.Msg   { return tx.msgs }
+func (tx mockTx) ValidateBasic() error { return nil }
+
+func TestRelayGuardDecorator(t *testing.T) {
+	relayer := sdk.AccAddress("relayer_____________")
+	other := sdk.AccAddress("other_______________")
+	recv := func(signer sdk.AccAddress) sdk.Msg {
+		return &channeltypes.MsgRecvPacket{Signer: signer.String()}
+	}
+	relayTx := mockTx{msgs: []sdk.Msg{&clienttypes.MsgUpdateClient{Signer: relayer.String()}, recv(relayer)}}
+
+	// the check state is a cache of the committed stores, recreated on commit
+	key := sdk.NewTransientStoreKey(relayguard.StoreKey)
+	ms := store.NewCommitMultiStore(dbm.NewMemDB())
+	ms.MountStoreWithDB(key, sdk.StoreTypeTransient, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+	checkState := ms.CacheMultiStore()
+	checkCtx := func() sdk.Context {
+		return sdk.NewContext(checkState, tmproto.Header{}, true, log.NewNopLogger())
+	}
+	commit := func() {
+		ms.Commit()
+		checkState = ms.CacheMultiStore()
+	}
+
+	tracker := relayguard.NewTracker(relayguard.Config{MaxFailures: 2}, key, checkCtx)
+	decorator := relayguard.NewRelayGuardDecorator(tracker)
+
+	var nextErr error
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		return ctx, nextErr
+	}
+	// the ante handler runs on a cache of the check state, discarded when
+	// the tx fails
+	txCtx := func() sdk.Context {
+		return checkCtx().WithMultiStore(checkState.CacheMultiStore())
+	}
+
+	// successful relay txs are not counted
+	_, err := decorator.AnteHandle(txCtx(), relayTx, false, next)
+	require.NoError(t, err)
+	require.Zero(t, tracker.Failures(relayer))
+
+	// failures are counted until the relayer is rejected
+	nextErr = channeltypes.ErrRedundantTx
+	_, err = decorator.AnteHandle(txCtx(), relayTx, false, next)
+	require.ErrorIs(t, err, channeltypes.ErrRedundantTx)
+	nextErr = sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "bad proof")
+	_, err = decorator.AnteHandle(txCtx(), relayTx, false, next)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.Equal(t, uint64(2), tracker.Failures(relayer))
+
+	nextErr = nil
+	_, err = decorator.AnteHandle(txCtx(), relayTx, false, next)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// other relayers, DeliverTx, simulations and other txs are not affected
+	_, err = decorator.AnteHandle(txCtx(), mockTx{msgs: []sdk.Msg{recv(other)}}, false, next)
+	require.NoError(t, err)
+	_, err = decorator.AnteHandle(txCtx().WithIsCheckTx(false), relayTx, false, next)
+	require.NoError(t, err)
+	_, err = decorator.AnteHandle(txCtx(), relayTx, true, next)
+	require.NoError(t, err)
+	send := banktypes.NewMsgSend(relayer, other, sdk.NewCoins(sdk.NewInt64Coin("orai", 1)))
+	_, err = decorator.AnteHandle(txCtx(), mockTx{msgs: []sdk.Msg{recv(relayer), send}}, false, next)
+	require.NoError(t, err)
+
+	// the next block starts clean
+	commit()
+	require.Zero(t, tracker.Failures(relayer))
+	_, err = decorator.AnteHandle(txCtx(), relayTx, false, next)
+	require.NoError(t, err)
+
+	require.Equal(t, relayguard.Stats{Failed: 1, Redundant: 1, Rejected: 1}, tracker.Stats())
+}
+
+func TestIsRelayTx(t *testing.T) {
+	addr := sdk.AccAddress("relayer_____________")
+
+	require.False(t, relayguard.IsRelayTx(nil))
+	require.True(t, relayguard.IsRelayTx([]sdk.Msg{
+		&clienttypes.MsgUpdateClient{},
+		&channeltypes.MsgRecvPacket{},
+		&channeltypes.MsgAcknowledgement{},
+		&channeltypes.MsgTimeout{},
+		&channeltypes.MsgTimeoutOnClose{},
+	}))
+	require.False(t, relayguard.IsRelayTx([]sdk.Msg{
+		&channeltypes.MsgRecvPacket{},
+		banktypes.NewMsgSend(addr, addr, nil),
+	}))
+}
@@ -0,0 +1,120 @@
+package relayguard
+
+import (
+	"encoding/binary"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// StoreKey is the key of the transient store the failures are counted in.
+	StoreKey = "transient_relayguard"
+
+	// DefaultMaxFailures is the number of failed relay txs after which a
+	// signer is rejected until the end of the block.
+	DefaultMaxFailures = 5
+)
+
+// Config of the relay tracker.
+type Config struct {
+	MaxFailures uint64
+}
+
+// DefaultConfig returns the default relay tracker config.
+func DefaultConfig() Config {
+	return Config{
+		MaxFailures: DefaultMaxFailures,
+	}
+}
+
+// Stats are the counters of the tracker since the node started.
+type Stats struct {
+	Failed    uint64
+	Redundant uint64
+	Rejected  uint64
+}
+
+// CheckStateFn returns a context on the check state of the app, such as
+// baseapp.NewContext(true, header) does.
+type CheckStateFn func() sdk.Context
+
+// Tracker counts the failed relay txs of every signer within the current
+// block.
+//
+// The counts are kept in a transient store of the check state, so that they
+// are node-local, not seen by DeliverTx and cleared on every commit. They are
+// written through a context on the check state rather than the one of the tx
+// being checked, because a failing tx discards every store write made by the
+// ante handler, which would also discard the failure.
+type Tracker struct {
+	config     Config
+	storeKey   sdk.StoreKey
+	checkState CheckStateFn
+
+	mu    sync.Mutex
+	stats Stats
+}
+
+// NewTracker returns a new tracker with the given config, counting the
+// failures in the transient store of storeKey.
+func NewTracker(config Config, storeKey sdk.StoreKey, checkState CheckStateFn) *Tracker {
+	return &Tracker{
+		config:     config,
+		storeKey:   storeKey,
+		checkState: checkState,
+	}
+}
+
+// IsRejected returns true if the signer had too many failed relay txs in the
+// current block.
+func (t *Tracker) IsRejected(signer sdk.AccAddress) bool {
+	if t.config.MaxFailures == 0 || t.Failures(signer) < t.config.MaxFailures {
+		return false
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.stats.Rejected++
+	telemetry.IncrCounter(1, "relayguard", "rejected")
+	return true
+}
+
+// RecordFailure counts a failed relay tx of the signer, redundant is true when
+// it failed because all of its packets were already relayed.
+func (t *Tracker) RecordFailure(signer sdk.AccAddress, redundant bool) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, t.Failures(signer)+1)
+	t.checkState().TransientStore(t.storeKey).Set(address.MustLengthPrefix(signer), bz)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if redundant {
+		t.stats.Redundant++
+		telemetry.IncrCounter(1, "relayguard", "redundant")
+		return
+	}
+	t.stats.Failed++
+	telemetry.IncrCounter(1, "relayguard", "failed")
+}
+
+// Failures returns the failed relay txs of the signer in the current block.
+func (t *Tracker) Failures(signer sdk.AccAddress) uint64 {
+	bz := t.checkState().TransientStore(t.storeKey).Get(address.MustLengthPrefix(signer))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// Stats returns the counters of the tracker.
+func (t *Tracker) Stats() Stats {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.stats
+}
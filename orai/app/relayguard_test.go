@@ -0,0 +1,82 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/CosmWasm/wasmd/x/wasm"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/oraichain/orai/app/relayguard"
+)
+
+// ensure that relay txs failing before their signatures are verified are not
+// counted against the signers they name, so that they cannot be used to get
+// a relayer rejected
+func TestRelayGuardCountsVerifiedSigners(t *testing.T) {
+	newAccount := func() simTestAccount {
+		privKey := secp256k1.GenPrivKey()
+		return simTestAccount{privKeys: []cryptotypes.PrivKey{privKey}, pubKey: privKey.PubKey()}
+	}
+	relayer, attacker := newAccount(), newAccount()
+	gapp := setupSimTestApp(t, relayer, attacker)
+
+	encodingConfig := MakeEncodingConfig()
+	tracker := relayguard.NewTracker(relayguard.Config{MaxFailures: 2}, gapp.tkeys[relayguard.StoreKey], func() sdk.Context {
+		return gapp.NewContext(true, tmproto.Header{})
+	})
+	anteHandler, err := NewAnteHandler(HandlerOptions{
+		HandlerOptions: ante.HandlerOptions{
+			AccountKeeper:   gapp.accountKeeper,
+			BankKeeper:      gapp.bankKeeper,
+			FeegrantKeeper:  gapp.feeGrantKeeper,
+			SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
+		},
+		IBCKeeper:         gapp.ibcKeeper,
+		TxCounterStoreKey: gapp.keys[wasm.StoreKey],
+		WasmConfig:        wasmtypes.DefaultWasmConfig(),
+		Cdc:               gapp.appCodec,
+		CommissionKeeper:  &gapp.CommissionKeeper,
+		GlobalFeeKeeper:   &gapp.GlobalFeeKeeper,
+		FeeTokenKeeper:    &gapp.FeeTokenKeeper,
+		SponsorKeeper:     &gapp.SponsorKeeper,
+		MsgLimitKeeper:    &gapp.MsgLimitKeeper,
+		MsgFilterKeeper:   &gapp.MsgFilterKeeper,
+		FeeShareKeeper:    &gapp.FeeShareKeeper,
+		RelayTracker:      tracker,
+	})
+	require.NoError(t, err)
+
+	checkTx := func(signer simTestAccount) error {
+		// the packet is on an unknown channel, so the relay fails in the ibc ante
+		packet := channeltypes.NewPacket([]byte("data"), 1, "transfer", "channel-0", "transfer", "channel-0", clienttypes.NewHeight(0, 100), 0)
+		msg := channeltypes.NewMsgRecvPacket(packet, []byte("proof"), clienttypes.NewHeight(0, 1), relayer.address().String())
+		tx, err := encodingConfig.TxConfig.TxDecoder()(buildSimTestTx(t, gapp, signer, []sdk.Msg{msg}, false))
+		require.NoError(t, err)
+		// as in CheckTx, the state changes of a failing tx are discarded
+		ctx := gapp.NewContext(true, tmproto.Header{ChainID: simTestChainID, Height: gapp.LastBlockHeight()})
+		_, err = anteHandler(ctx.WithMultiStore(ctx.MultiStore().CacheMultiStore()), tx, false)
+		return err
+	}
+
+	// txs naming the relayer but signed by someone else fail without counting
+	for i := 0; i < 5; i++ {
+		require.ErrorIs(t, checkTx(attacker), sdkerrors.ErrInvalidPubKey)
+	}
+	require.Zero(t, tracker.Failures(relayer.address()))
+
+	// the failed relay txs of the relayer itself are counted
+	require.ErrorIs(t, checkTx(relayer), capabilitytypes.ErrCapabilityNotFound)
+	require.ErrorIs(t, checkTx(relayer), capabilitytypes.ErrCapabilityNotFound)
+	require.Equal(t, uint64(2), tracker.Failures(relayer.address()))
+	require.ErrorIs(t, checkTx(relayer), sdkerrors.ErrUnauthorized)
+}
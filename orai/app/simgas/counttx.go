@@ -0,0 +1,34 @@
+package simgas
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+)
+
+// CountTXDecorator wraps wasmkeeper.CountTXDecorator, which skips
+// simulations. Simulations are charged the gas of counting the tx on a
+// branch of the state that is discarded, and still get no tx counter.
+type CountTXDecorator struct {
+	counter *wasmkeeper.CountTXDecorator
+}
+
+func NewCountTXDecorator(storeKey sdk.StoreKey) CountTXDecorator {
+	return CountTXDecorator{counter: wasmkeeper.NewCountTXDecorator(storeKey)}
+}
+
+func (ctd CountTXDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !simulate {
+		return ctd.counter.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	_, err := ctd.counter.AnteHandle(cacheCtx, tx, false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		return ctx, nil
+	})
+	if err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,95 @@
+package simgas
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// BankKeeper defines the expected bank keeper.
+type BankKeeper interface {
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+}
+
+// GlobalFeeKeeper defines the expected globalfee keeper.
+type GlobalFeeKeeper interface {
+	GetMinimumGasPrices(ctx sdk.Context) sdk.DecCoins
+}
+
+// DeductFeeGasDecorator charges, in simulation of a tx without fees, the gas
+// of paying a fee: the bytes of the fee coin and the bank transfer to the fee
+// collector. The transfer runs on a branch of the state that is discarded.
+// It must be placed before the decorator deducting the fees.
+//
+// The fee is paid in the first denom of the minimum gas prices the payer
+// holds. Its size is estimated from the payer's balance, an upper bound of
+// any fee they can pay.
+type DeductFeeGasDecorator struct {
+	ak              ante.AccountKeeper
+	bankKeeper      BankKeeper
+	globalFeeKeeper GlobalFeeKeeper
+}
+
+func NewDeductFeeGasDecorator(ak ante.AccountKeeper, bk BankKeeper, gk GlobalFeeKeeper) DeductFeeGasDecorator {
+	return DeductFeeGasDecorator{
+		ak:              ak,
+		bankKeeper:      bk,
+		globalFeeKeeper: gk,
+	}
+}
+
+func (dfgd DeductFeeGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if !simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+	if !feeTx.GetFee().IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	payer := feeTx.FeePayer()
+	if granter := feeTx.FeeGranter(); granter != nil {
+		payer = granter
+	}
+
+	balance, found := dfgd.feeBalance(ctx, payer)
+	if !found {
+		return next(ctx, tx, simulate)
+	}
+
+	params := dfgd.ak.GetParams(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()))
+	feeSize := (&txtypes.Fee{Amount: sdk.NewCoins(balance)}).Size()
+	ctx.GasMeter().ConsumeGas(params.TxSizeCostPerByte*sdk.Gas(feeSize), "txSize")
+
+	// errors only mean the tx will fail in DeliverTx as well
+	cacheCtx, _ := ctx.CacheContext()
+	_ = dfgd.bankKeeper.SendCoinsFromAccountToModule(cacheCtx, payer, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewCoin(balance.Denom, sdk.OneInt())))
+
+	return next(ctx, tx, simulate)
+}
+
+// feeBalance returns the balance of the payer in the first fee denom they
+// hold, without consuming gas.
+func (dfgd DeductFeeGasDecorator) feeBalance(ctx sdk.Context, payer sdk.AccAddress) (sdk.Coin, bool) {
+	lookupCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	prices := dfgd.globalFeeKeeper.GetMinimumGasPrices(lookupCtx)
+	if len(prices) == 0 {
+		prices = ctx.MinGasPrices()
+	}
+
+	for _, price := range prices {
+		if balance := dfgd.bankKeeper.GetBalance(lookupCtx, payer, price.Denom); balance.IsPositive() {
+			return balance, true
+		}
+	}
+
+	return sdk.Coin{}, false
+}
@@ -0,0 +1,69 @@
+// Package simgas provides ante decorators that make the gas used when
+// simulating a tx match the gas used when delivering it. Clients estimating
+// gas send the tx without signatures and usually without fees, so every
+// decorator whose cost depends on them charges for placeholders instead.
+package simgas
+
+import (
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// SignatureSize is the size of a secp256k1, secp256r1 or ed25519 signature.
+const SignatureSize = 64
+
+// simPubKey stands in for signers without a known pubkey, secp256k1 being the
+// most expensive key type accepted.
+var simPubKey = &secp256k1.PubKey{Key: make([]byte, secp256k1.PubKeySize)}
+
+// PlaceholderSignature returns signature data shaped like a complete
+// signature by pubKey, keeping the sign mode of data. Multisig keys are
+// signed by exactly the threshold number of their first keys.
+func PlaceholderSignature(pubKey cryptotypes.PubKey, data signing.SignatureData) signing.SignatureData {
+	signMode := signing.SignMode_SIGN_MODE_DIRECT
+	if single, ok := data.(*signing.SingleSignatureData); ok {
+		signMode = single.SignMode
+	}
+
+	return placeholderSignature(pubKey, signMode)
+}
+
+func placeholderSignature(pubKey cryptotypes.PubKey, signMode signing.SignMode) signing.SignatureData {
+	multiPubKey, ok := pubKey.(multisig.PubKey)
+	if !ok {
+		return &signing.SingleSignatureData{SignMode: signMode, Signature: make([]byte, SignatureSize)}
+	}
+
+	pubKeys := multiPubKey.GetPubKeys()
+	bitArray := cryptotypes.NewCompactBitArray(len(pubKeys))
+	var sigs []signing.SignatureData
+	for i := 0; i < int(multiPubKey.GetThreshold()) && i < len(pubKeys); i++ {
+		bitArray.SetIndex(i, true)
+		sigs = append(sigs, placeholderSignature(pubKeys[i], signMode))
+	}
+
+	return &signing.MultiSignatureData{BitArray: bitArray, Signatures: sigs}
+}
+
+// IsIncompleteSignature reports whether data lacks any signature bytes, as
+// in txs sent for simulation.
+func IsIncompleteSignature(data signing.SignatureData) bool {
+	switch data := data.(type) {
+	case *signing.SingleSignatureData:
+		return len(data.Signature) == 0
+	case *signing.MultiSignatureData:
+		if len(data.Signatures) == 0 {
+			return true
+		}
+		for _, s := range data.Signatures {
+			if IsIncompleteSignature(s) {
+				return true
+			}
+		}
+		return false
+	default:
+		return true
+	}
+}
@@ -0,0 +1,43 @@
+package simgas_test
+
+import (
+	"testing"
+
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oraichain/orai/app/simgas"
+)
+
+func TestPlaceholderSignature(t *testing.T) {
+	secp256r1Key, err := secp256r1.GenPrivKey()
+	require.NoError(t, err)
+	pubKeys := []cryptotypes.PubKey{secp256k1.GenPrivKey().PubKey(), secp256r1Key.PubKey(), secp256k1.GenPrivKey().PubKey()}
+	aminoJSON := &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON}
+
+	for _, pubKey := range pubKeys[:2] {
+		data := simgas.PlaceholderSignature(pubKey, aminoJSON)
+		require.Equal(t, &signing.SingleSignatureData{
+			SignMode:  signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+			Signature: make([]byte, simgas.SignatureSize),
+		}, data)
+		require.False(t, simgas.IsIncompleteSignature(data))
+	}
+
+	data := simgas.PlaceholderSignature(kmultisig.NewLegacyAminoPubKey(2, pubKeys), nil)
+	multiData, ok := data.(*signing.MultiSignatureData)
+	require.True(t, ok)
+	require.Len(t, multiData.Signatures, 2)
+	require.True(t, multiData.BitArray.GetIndex(0))
+	require.True(t, multiData.BitArray.GetIndex(1))
+	require.False(t, multiData.BitArray.GetIndex(2))
+	require.Equal(t, signing.SignMode_SIGN_MODE_DIRECT, multiData.Signatures[0].(*signing.SingleSignatureData).SignMode)
+	require.False(t, simgas.IsIncompleteSignature(data))
+
+	require.True(t, simgas.IsIncompleteSignature(aminoJSON))
+	require.True(t, simgas.IsIncompleteSignature(&signing.MultiSignatureData{}))
+}
@@ -0,0 +1,74 @@
+package simgas
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// SigGasConsumeDecorator replaces ante.SigGasConsumeDecorator. In simulation
+// it charges the signature verification gas of placeholder signatures, so
+// multisig accounts pay for their threshold of signatures instead of failing
+// on the single empty signature sent by clients.
+type SigGasConsumeDecorator struct {
+	ak             ante.AccountKeeper
+	sigGasConsumer ante.SignatureVerificationGasConsumer
+
+	fallback ante.SigGasConsumeDecorator
+}
+
+func NewSigGasConsumeDecorator(ak ante.AccountKeeper, sigGasConsumer ante.SignatureVerificationGasConsumer) SigGasConsumeDecorator {
+	return SigGasConsumeDecorator{
+		ak:             ak,
+		sigGasConsumer: sigGasConsumer,
+		fallback:       ante.NewSigGasConsumeDecorator(ak, sigGasConsumer),
+	}
+}
+
+func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if !simulate {
+		return sgcd.fallback.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	params := sgcd.ak.GetParams(ctx)
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+
+	signerAddrs := sigTx.GetSigners()
+	for i, sig := range sigs {
+		signerAcc, err := ante.GetSignerAcc(ctx, sgcd.ak, signerAddrs[i])
+		if err != nil {
+			return ctx, err
+		}
+
+		pubKey := signerAcc.GetPubKey()
+		if pubKey == nil {
+			pubKey = simPubKey
+		}
+
+		data := sig.Data
+		if IsIncompleteSignature(data) {
+			data = PlaceholderSignature(pubKey, data)
+		}
+
+		err = sgcd.sigGasConsumer(ctx.GasMeter(), signing.SignatureV2{
+			PubKey:   pubKey,
+			Data:     data,
+			Sequence: sig.Sequence,
+		}, params)
+		if err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
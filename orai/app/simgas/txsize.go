@@ -0,0 +1,97 @@
+package simgas
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+// ConsumeTxSizeGasDecorator replaces ante.ConsumeTxSizeGasDecorator. In
+// simulation it charges for the size the tx will have once signed, found by
+// re-encoding the simulated tx with placeholder signatures. The SDK instead
+// adds an amino encoded signature and pubkey per signer, which counts the
+// pubkey twice and multisig signatures up to TxSigLimit times.
+//
+// Txs that are not protobuf encoded fall back to the SDK estimate.
+type ConsumeTxSizeGasDecorator struct {
+	ak ante.AccountKeeper
+
+	fallback ante.ConsumeTxSizeGasDecorator
+}
+
+func NewConsumeGasForTxSizeDecorator(ak ante.AccountKeeper) ConsumeTxSizeGasDecorator {
+	return ConsumeTxSizeGasDecorator{
+		ak:       ak,
+		fallback: ante.NewConsumeGasForTxSizeDecorator(ak),
+	}
+}
+
+func (cgts ConsumeTxSizeGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !simulate {
+		return cgts.fallback.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	size, ok := cgts.signedTxSize(ctx, tx)
+	if !ok {
+		return cgts.fallback.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	params := cgts.ak.GetParams(ctx)
+	ctx.GasMeter().ConsumeGas(params.TxSizeCostPerByte*sdk.Gas(size), "txSize")
+
+	return next(ctx, tx, simulate)
+}
+
+// signedTxSize returns the size of the tx bytes with every incomplete
+// signature replaced by a placeholder.
+func (cgts ConsumeTxSizeGasDecorator) signedTxSize(ctx sdk.Context, tx sdk.Tx) (int, bool) {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return 0, false
+	}
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return 0, false
+	}
+
+	var raw txtypes.TxRaw
+	if err := raw.Unmarshal(ctx.TxBytes()); err != nil {
+		return 0, false
+	}
+	var authInfo txtypes.AuthInfo
+	if err := authInfo.Unmarshal(raw.AuthInfoBytes); err != nil {
+		return 0, false
+	}
+	if len(authInfo.SignerInfos) != len(sigs) || len(raw.Signatures) != len(sigs) {
+		return 0, false
+	}
+
+	// looking up pubkeys is not part of the delivered tx
+	lookupCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	signers := sigTx.GetSigners()
+	for i, sig := range sigs {
+		if !IsIncompleteSignature(sig.Data) {
+			continue
+		}
+
+		pubKey := sig.PubKey
+		if pubKey == nil {
+			if acc := cgts.ak.GetAccount(lookupCtx, signers[i]); acc != nil {
+				pubKey = acc.GetPubKey()
+			}
+		}
+		if pubKey == nil {
+			pubKey = simPubKey
+		}
+
+		authInfo.SignerInfos[i].ModeInfo, raw.Signatures[i] = authtx.SignatureDataToModeInfoAndSig(PlaceholderSignature(pubKey, sig.Data))
+	}
+
+	if raw.AuthInfoBytes, err = authInfo.Marshal(); err != nil {
+		return 0, false
+	}
+
+	return raw.Size(), true
+}
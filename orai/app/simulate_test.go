@@ -0,0 +1,235 @@
+package app
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/CosmWasm/wasmd/x/wasm"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	db "github.com/tendermint/tm-db"
+
+	globalfeetypes "github.com/oraichain/orai/x/globalfee/types"
+)
+
+const (
+	simTestChainID  = "simulate-test"
+	simTestGasLimit = 5_000_000
+)
+
+// simTestAccount is a genesis account signing with a single key or with the
+// threshold of the sub keys of a multisig key.
+type simTestAccount struct {
+	privKeys []cryptotypes.PrivKey
+	pubKey   cryptotypes.PubKey
+}
+
+func (acc simTestAccount) address() sdk.AccAddress {
+	return sdk.AccAddress(acc.pubKey.Address())
+}
+
+func newSimTestMultisigAccount(threshold, n int) simTestAccount {
+	privKeys := make([]cryptotypes.PrivKey, n)
+	pubKeys := make([]cryptotypes.PubKey, n)
+	for i := range privKeys {
+		privKeys[i] = secp256k1.GenPrivKey()
+		pubKeys[i] = privKeys[i].PubKey()
+	}
+
+	return simTestAccount{privKeys: privKeys, pubKey: kmultisig.NewLegacyAminoPubKey(threshold, pubKeys)}
+}
+
+func setupSimTestApp(t *testing.T, accounts ...simTestAccount) *OraichainApp {
+	return setupSimTestAppWithModules(t, nil, accounts...)
+}
+
+// setupSimTestAppWithModules sets up an app with only the modules of the
+// subset, funding the accounts at genesis
+func setupSimTestAppWithModules(t *testing.T, modules moduleSubset, accounts ...simTestAccount) *OraichainApp {
+	encodingConfig := MakeEncodingConfig()
+	gapp := newOraichainApp(log.NewNopLogger(), db.NewMemDB(), nil, true, map[int64]bool{}, t.TempDir(), 0, encodingConfig, wasm.EnableAllProposals, EmptyAppOptions{}, modules, emptyWasmOpts)
+
+	genesisState := NewDefaultGenesisState(gapp.appCodec)
+
+	authGenesis := authtypes.DefaultGenesisState()
+	bankGenesis := banktypes.DefaultGenesisState()
+	for _, acc := range accounts {
+		genAcc, err := codectypes.NewAnyWithValue(authtypes.NewBaseAccountWithAddress(acc.address()))
+		require.NoError(t, err)
+		authGenesis.Accounts = append(authGenesis.Accounts, genAcc)
+		bankGenesis.Balances = append(bankGenesis.Balances, banktypes.Balance{
+			Address: acc.address().String(),
+			Coins:   sdk.NewCoins(sdk.NewInt64Coin("orai", 1_000_000_000_000)),
+		})
+	}
+	genesisState[authtypes.ModuleName] = gapp.appCodec.MustMarshalJSON(authGenesis)
+	genesisState[banktypes.ModuleName] = gapp.appCodec.MustMarshalJSON(bankGenesis)
+
+	globalFeeGenesis := globalfeetypes.DefaultGenesisState()
+	globalFeeGenesis.Params.MinimumGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec("orai", sdk.NewDecWithPrec(1, 3)))
+	genesisState[globalfeetypes.ModuleName] = gapp.appCodec.MustMarshalJSON(globalFeeGenesis)
+
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+	gapp.InitChain(abci.RequestInitChain{
+		ChainId:         simTestChainID,
+		ConsensusParams: &abci.ConsensusParams{Block: &abci.BlockParams{MaxBytes: 22020096, MaxGas: 100_000_000}},
+		AppStateBytes:   stateBytes,
+	})
+	gapp.Commit()
+
+	// simulations run on the last committed block, which must not be genesis
+	header := tmproto.Header{ChainID: simTestChainID, Height: gapp.LastBlockHeight() + 1, Time: time.Now()}
+	gapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	gapp.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	gapp.Commit()
+
+	return gapp
+}
+
+// buildSimTestTx encodes msgs signed by acc. When simulate is set the
+// signatures are left empty, as done by clients estimating gas, and no fee
+// is paid.
+func buildSimTestTx(t *testing.T, gapp *OraichainApp, acc simTestAccount, msgs []sdk.Msg, simulate bool) []byte {
+	txConfig := MakeEncodingConfig().TxConfig
+	ctx := gapp.NewContext(true, tmproto.Header{Height: gapp.LastBlockHeight()})
+	authAcc := gapp.accountKeeper.GetAccount(ctx, acc.address())
+
+	signMode := signing.SignMode_SIGN_MODE_DIRECT
+	if _, ok := acc.pubKey.(multisig.PubKey); ok {
+		signMode = signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+	}
+
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(msgs...))
+	builder.SetGasLimit(simTestGasLimit)
+	if !simulate {
+		builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("orai", simTestGasLimit/1000)))
+	}
+	require.NoError(t, builder.SetSignatures(signing.SignatureV2{
+		PubKey:   acc.pubKey,
+		Data:     &signing.SingleSignatureData{SignMode: signMode},
+		Sequence: authAcc.GetSequence(),
+	}))
+
+	if !simulate {
+		signerData := authsigning.SignerData{
+			ChainID:       simTestChainID,
+			AccountNumber: authAcc.GetAccountNumber(),
+			Sequence:      authAcc.GetSequence(),
+		}
+		signBytes, err := txConfig.SignModeHandler().GetSignBytes(signMode, signerData, builder.GetTx())
+		require.NoError(t, err)
+
+		var data signing.SignatureData
+		if multiPubKey, ok := acc.pubKey.(multisig.PubKey); ok {
+			multiData := multisig.NewMultisig(len(acc.privKeys))
+			for i := 0; i < int(multiPubKey.GetThreshold()); i++ {
+				sig, err := acc.privKeys[i].Sign(signBytes)
+				require.NoError(t, err)
+				multisig.AddSignature(multiData, &signing.SingleSignatureData{SignMode: signMode, Signature: sig}, i)
+			}
+			data = multiData
+		} else {
+			sig, err := acc.privKeys[0].Sign(signBytes)
+			require.NoError(t, err)
+			data = &signing.SingleSignatureData{SignMode: signMode, Signature: sig}
+		}
+
+		require.NoError(t, builder.SetSignatures(signing.SignatureV2{
+			PubKey:   acc.pubKey,
+			Data:     data,
+			Sequence: authAcc.GetSequence(),
+		}))
+	}
+
+	bz, err := txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	return bz
+}
+
+func deliverSimTestTx(t *testing.T, gapp *OraichainApp, bz []byte) uint64 {
+	header := tmproto.Header{ChainID: simTestChainID, Height: gapp.LastBlockHeight() + 1, Time: time.Now()}
+	gapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	res := gapp.DeliverTx(abci.RequestDeliverTx{Tx: bz})
+	require.True(t, res.IsOK(), res.Log)
+	gapp.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	gapp.Commit()
+
+	return uint64(res.GasUsed)
+}
+
+// ensure that simulated txs use as much gas as the same txs once signed and
+// delivered with fees, give or take the fee amount being estimated
+func TestSimulatedGasMatchesDelivered(t *testing.T) {
+	secp256k1Acc := simTestAccount{privKeys: []cryptotypes.PrivKey{secp256k1.GenPrivKey()}}
+	secp256k1Acc.pubKey = secp256k1Acc.privKeys[0].PubKey()
+
+	secp256r1Key, err := secp256r1.GenPrivKey()
+	require.NoError(t, err)
+	secp256r1Acc := simTestAccount{privKeys: []cryptotypes.PrivKey{secp256r1Key}, pubKey: secp256r1Key.PubKey()}
+
+	multisigAcc := newSimTestMultisigAccount(2, 3)
+
+	gapp := setupSimTestApp(t, secp256k1Acc, secp256r1Acc, multisigAcc)
+
+	counterCode, err := os.ReadFile("../../interchaintest/contracts/counter.wasm")
+	require.NoError(t, err)
+	contract := wasmkeeper.BuildContractAddressClassic(1, 1)
+
+	send := func(from simTestAccount) sdk.Msg {
+		return banktypes.NewMsgSend(from.address(), secp256k1Acc.address(), sdk.NewCoins(sdk.NewInt64Coin("orai", 1)))
+	}
+	increment := func(from simTestAccount) sdk.Msg {
+		return &wasm.MsgExecuteContract{Sender: from.address().String(), Contract: contract.String(), Msg: []byte(`{"increment":{}}`)}
+	}
+
+	corpus := []struct {
+		name   string
+		signer simTestAccount
+		msgs   []sdk.Msg
+	}{
+		{"secp256k1 send with new pubkey", secp256k1Acc, []sdk.Msg{send(secp256k1Acc)}},
+		{"secp256k1 send", secp256k1Acc, []sdk.Msg{send(secp256k1Acc)}},
+		{"secp256r1 send", secp256r1Acc, []sdk.Msg{send(secp256r1Acc)}},
+		{"secp256r1 multiple sends", secp256r1Acc, []sdk.Msg{send(secp256r1Acc), send(secp256r1Acc)}},
+		{"multisig send", multisigAcc, []sdk.Msg{send(multisigAcc)}},
+		{"store code", secp256k1Acc, []sdk.Msg{&wasm.MsgStoreCode{Sender: secp256k1Acc.address().String(), WASMByteCode: counterCode}}},
+		{"instantiate", secp256k1Acc, []sdk.Msg{&wasm.MsgInstantiateContract{
+			Sender: secp256k1Acc.address().String(), CodeID: 1, Label: "counter", Msg: []byte(`{"count":0}`),
+		}}},
+		{"secp256k1 execute", secp256k1Acc, []sdk.Msg{increment(secp256k1Acc)}},
+		{"secp256r1 execute", secp256r1Acc, []sdk.Msg{increment(secp256r1Acc)}},
+		{"multisig execute", multisigAcc, []sdk.Msg{increment(multisigAcc)}},
+	}
+
+	// the fee amount is unknown when simulating, the payer's balance is
+	// used as its upper bound
+	const tolerance = 200
+
+	for _, tc := range corpus {
+		gasInfo, _, err := gapp.Simulate(buildSimTestTx(t, gapp, tc.signer, tc.msgs, true))
+		require.NoError(t, err, tc.name)
+
+		delivered := deliverSimTestTx(t, gapp, buildSimTestTx(t, gapp, tc.signer, tc.msgs, false))
+
+		require.GreaterOrEqual(t, gasInfo.GasUsed, delivered, tc.name)
+		require.LessOrEqual(t, gasInfo.GasUsed-delivered, uint64(tolerance), tc.name)
+	}
+}
@@ -0,0 +1,150 @@
+package app
+
+import (
+	"os"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/oraichain/orai/x/tokenfactory"
+	tokenfactorykeeper "github.com/oraichain/orai/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/oraichain/orai/x/tokenfactory/types"
+)
+
+// ensure that denoms are created, minted and burnt by their admin, that the
+// before send hooks block the sends, and that the denoms survive a genesis
+// export and import
+func TestTokenFactory(t *testing.T) {
+	gapp, ctx := setupWasmBindingApp(t)
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(gapp.TokenFactoryKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	creator := sdk.AccAddress("creator_____________")
+	other := sdk.AccAddress("other_______________")
+	fee := gapp.TokenFactoryKeeper.GetParams(ctx).DenomCreationFee
+	require.NoError(t, gapp.bankKeeper.MintCoins(ctx, minttypes.ModuleName, fee))
+	require.NoError(t, gapp.bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, creator, fee))
+
+	// the creation fee goes to the community pool
+	res, err := msgServer.CreateDenom(goCtx, tokenfactorytypes.NewMsgCreateDenom(creator, "token"))
+	require.NoError(t, err)
+	denom := res.NewTokenDenom
+	require.Equal(t, "factory/"+creator.String()+"/token", denom)
+	require.True(t, gapp.bankKeeper.GetAllBalances(ctx, creator).IsZero())
+	require.Equal(t, sdk.NewDecCoinsFromCoins(fee...), gapp.distrKeeper.GetFeePoolCommunityCoins(ctx))
+	_, err = msgServer.CreateDenom(goCtx, tokenfactorytypes.NewMsgCreateDenom(creator, "token"))
+	require.ErrorIs(t, err, tokenfactorytypes.ErrDenomExists)
+	require.Equal(t, []string{denom}, gapp.TokenFactoryKeeper.GetDenomsFromCreator(ctx, creator.String()))
+	metadata, found := gapp.bankKeeper.GetDenomMetaData(ctx, denom)
+	require.True(t, found)
+	require.Equal(t, denom, metadata.Base)
+
+	// only the admin mints and burns
+	_, err = msgServer.Mint(goCtx, tokenfactorytypes.NewMsgMint(creator, sdk.NewInt64Coin(denom, 1000), ""))
+	require.NoError(t, err)
+	_, err = msgServer.Mint(goCtx, tokenfactorytypes.NewMsgMint(other, sdk.NewInt64Coin(denom, 1000), ""))
+	require.ErrorIs(t, err, tokenfactorytypes.ErrUnauthorized)
+	require.NoError(t, gapp.bankKeeper.SendCoins(ctx, creator, other, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	_, err = msgServer.Burn(goCtx, tokenfactorytypes.NewMsgBurn(creator, sdk.NewInt64Coin(denom, 400)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(denom, 500), gapp.bankKeeper.GetBalance(ctx, creator, denom))
+	require.Equal(t, sdk.NewInt64Coin(denom, 600), gapp.bankKeeper.GetSupply(ctx, denom))
+
+	// the reflect contract has no sudo entry point, so it rejects every send
+	code, err := os.ReadFile("./bytecode/reflect.wasm")
+	require.NoError(t, err)
+	codeID, _, err := gapp.ContractKeeper.Create(ctx, creator, code, nil)
+	require.NoError(t, err)
+	contract, _, err := gapp.ContractKeeper.Instantiate(ctx, codeID, creator, creator, []byte(`{}`), "reflect", nil)
+	require.NoError(t, err)
+
+	_, err = msgServer.SetBeforeSendHook(goCtx, tokenfactorytypes.NewMsgSetBeforeSendHook(creator, denom, other.String()))
+	require.ErrorIs(t, err, tokenfactorytypes.ErrInvalidHook)
+	_, err = msgServer.SetBeforeSendHook(goCtx, tokenfactorytypes.NewMsgSetBeforeSendHook(creator, denom, contract.String()))
+	require.NoError(t, err)
+
+	err = gapp.bankKeeper.SendCoins(ctx, other, creator, sdk.NewCoins(sdk.NewInt64Coin(denom, 100)))
+	require.ErrorIs(t, err, tokenfactorytypes.ErrSendBlocked)
+	err = gapp.bankKeeper.InputOutputCoins(ctx,
+		[]banktypes.Input{banktypes.NewInput(other, sdk.NewCoins(sdk.NewInt64Coin(denom, 100)))},
+		[]banktypes.Output{banktypes.NewOutput(creator, sdk.NewCoins(sdk.NewInt64Coin(denom, 100)))},
+	)
+	require.ErrorIs(t, err, tokenfactorytypes.ErrSendBlocked)
+	sendMsg := banktypes.NewMsgSend(other, creator, sdk.NewCoins(sdk.NewInt64Coin(denom, 100)))
+	_, err = gapp.MsgServiceRouter().Handler(sendMsg)(ctx, sendMsg)
+	require.ErrorIs(t, err, tokenfactorytypes.ErrSendBlocked)
+	_, err = msgServer.Burn(goCtx, tokenfactorytypes.NewMsgBurn(creator, sdk.NewInt64Coin(denom, 100)))
+	require.ErrorIs(t, err, tokenfactorytypes.ErrSendBlocked)
+
+	// removing the hook unblocks the sends
+	_, err = msgServer.SetBeforeSendHook(goCtx, tokenfactorytypes.NewMsgSetBeforeSendHook(creator, denom, ""))
+	require.NoError(t, err)
+	require.NoError(t, gapp.bankKeeper.SendCoins(ctx, other, creator, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	_, err = msgServer.SetBeforeSendHook(goCtx, tokenfactorytypes.NewMsgSetBeforeSendHook(creator, denom, contract.String()))
+	require.NoError(t, err)
+
+	// the new admin takes over
+	_, err = msgServer.ChangeAdmin(goCtx, tokenfactorytypes.NewMsgChangeAdmin(creator, denom, other.String()))
+	require.NoError(t, err)
+	_, err = msgServer.ChangeAdmin(goCtx, tokenfactorytypes.NewMsgChangeAdmin(creator, denom, creator.String()))
+	require.ErrorIs(t, err, tokenfactorytypes.ErrUnauthorized)
+
+	genesis := tokenfactory.ExportGenesis(ctx, gapp.TokenFactoryKeeper)
+	require.NoError(t, genesis.Validate())
+	require.Equal(t, []tokenfactorytypes.GenesisDenom{{
+		Denom:             denom,
+		AuthorityMetadata: tokenfactorytypes.DenomAuthorityMetadata{Admin: other.String()},
+		BeforeSendHook:    contract.String(),
+	}}, genesis.FactoryDenoms)
+
+	importApp, importCtx := setupWasmBindingApp(t)
+	tokenfactory.InitGenesis(importCtx, importApp.TokenFactoryKeeper, importApp.accountKeeper, *genesis)
+	require.Equal(t, genesis, tokenfactory.ExportGenesis(importCtx, importApp.TokenFactoryKeeper))
+	require.Equal(t, []string{denom}, importApp.TokenFactoryKeeper.GetDenomsFromCreator(importCtx, creator.String()))
+}
+
+// ensure that a before send hook rejecting the sends of a denom held by the
+// fee collector does not halt the chain when the fees are allocated
+func TestTokenFactoryHookOnFees(t *testing.T) {
+	gapp, ctx := setupWasmBindingApp(t)
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(gapp.TokenFactoryKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	creator := sdk.AccAddress("creator_____________")
+	fee := gapp.TokenFactoryKeeper.GetParams(ctx).DenomCreationFee
+	require.NoError(t, gapp.bankKeeper.MintCoins(ctx, minttypes.ModuleName, fee))
+	require.NoError(t, gapp.bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, creator, fee))
+	res, err := msgServer.CreateDenom(goCtx, tokenfactorytypes.NewMsgCreateDenom(creator, "token"))
+	require.NoError(t, err)
+	denom := res.NewTokenDenom
+
+	// the denom is paid as a fee before the hook rejects its sends
+	fees := sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))
+	_, err = msgServer.Mint(goCtx, tokenfactorytypes.NewMsgMint(creator, fees[0], ""))
+	require.NoError(t, err)
+	require.NoError(t, gapp.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, authtypes.FeeCollectorName, fees))
+
+	// the reflect contract has no sudo entry point, so it rejects every send
+	code, err := os.ReadFile("./bytecode/reflect.wasm")
+	require.NoError(t, err)
+	codeID, _, err := gapp.ContractKeeper.Create(ctx, creator, code, nil)
+	require.NoError(t, err)
+	contract, _, err := gapp.ContractKeeper.Instantiate(ctx, codeID, creator, creator, []byte(`{}`), "reflect", nil)
+	require.NoError(t, err)
+	_, err = msgServer.SetBeforeSendHook(goCtx, tokenfactorytypes.NewMsgSetBeforeSendHook(creator, denom, contract.String()))
+	require.NoError(t, err)
+
+	// distribution allocates the fees of the previous block from height 2
+	ctx = ctx.WithBlockHeight(2)
+	require.NotPanics(t, func() {
+		gapp.BeginBlocker(ctx, abci.RequestBeginBlock{Header: ctx.BlockHeader()})
+	})
+	feeCollector := gapp.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	require.True(t, gapp.bankKeeper.GetBalance(ctx, feeCollector, denom).IsZero())
+	require.Equal(t, fees.AmountOf(denom), gapp.distrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(denom).TruncateInt())
+}
@@ -0,0 +1,255 @@
+package app
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/oraichain/orai/app/upgrades"
+	v0417 "github.com/oraichain/orai/app/upgrades/v0417"
+	v0420 "github.com/oraichain/orai/app/upgrades/v0420"
+)
+
+// Upgrades lists every upgrade the app can apply, oldest first
+var Upgrades = []upgrades.Upgrade{
+	v0417.Upgrade,
+	v0420.Upgrade,
+}
+
+func (app *OraichainApp) upgradeHandler() {
+	if err := upgrades.ValidateStoreUpgrades(Upgrades, app.isMountedStore); err != nil {
+		panic(err)
+	}
+
+	for _, upgrade := range Upgrades {
+		app.upgradeKeeper.SetUpgradeHandler(upgrade.UpgradeName, app.reportingUpgradeHandler(upgrade))
+	}
+
+	upgrade, height, found := app.pendingUpgrade()
+	if found {
+		// configure store loader that checks if version == upgradeHeight and applies store upgrades
+		storeUpgrades := upgrade.StoreUpgrades
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(height, &storeUpgrades))
+	}
+}
+
+// reportingUpgradeHandler wraps the handler of the upgrade to build its
+// report: the migrations recorded by upgrades.RunMigrations, the crisis
+// invariants checked afterwards and the store hashes before the upgrade.
+// The report is emitted as events and written to the node home, then
+// completed with the store hashes after the upgrade at the next height.
+// Broken invariants are reported and logged, they don't fail the upgrade,
+// which would halt the chain.
+func (app *OraichainApp) reportingUpgradeHandler(upgrade upgrades.Upgrade) upgradetypes.UpgradeHandler {
+	handler := upgrade.CreateUpgradeHandler(app.mm, app.configurator, app.upgradeKeepers())
+
+	return func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		report := &upgrades.Report{
+			UpgradeName: plan.Name,
+			Height:      ctx.BlockHeight(),
+			StoreHashes: app.storeHashes(),
+		}
+
+		toVM, err := handler(upgrades.WithReport(ctx, report), plan, fromVM)
+		if err != nil {
+			return nil, err
+		}
+
+		report.Invariants = app.checkInvariants(ctx)
+		ctx.EventManager().EmitEvents(report.MigrationEvents())
+		app.writeUpgradeReport(ctx, report)
+		app.upgradeReport = report
+
+		if broken := report.BrokenInvariants(); len(broken) > 0 {
+			ctx.Logger().Error("upgrade breaks invariants", "upgrade", plan.Name, "broken", len(broken), "invariants", strings.Join(broken, ""))
+		}
+
+		return toVM, nil
+	}
+}
+
+// loadUpgradeReport reloads the report of the upgrade applied at the last
+// committed height from the node home, so that it is still completed at the
+// next height when the node restarts in between.
+func (app *OraichainApp) loadUpgradeReport(ctx sdk.Context) {
+	name, height := app.upgradeKeeper.GetLastCompletedUpgrade(ctx)
+	if name == "" || height != app.LastBlockHeight() {
+		return
+	}
+
+	report, err := upgrades.ReadReportFile(app.homePath, name)
+	if err != nil {
+		ctx.Logger().Error("failed to read upgrade report", "upgrade", name, "err", err)
+		return
+	}
+	app.upgradeReport = report
+}
+
+// completeUpgradeReport sets the store hashes after the upgrade applied at
+// the previous height, now committed, and returns their events.
+func (app *OraichainApp) completeUpgradeReport(ctx sdk.Context) []abci.Event {
+	report := app.upgradeReport
+	if report == nil || ctx.BlockHeight() != report.Height+1 {
+		return nil
+	}
+	app.upgradeReport = nil
+
+	after := app.storeHashes()
+	for i := range report.StoreHashes {
+		report.StoreHashes[i].After = after[i].Before
+	}
+	app.writeUpgradeReport(ctx, report)
+
+	return report.StoreHashEvents().ToABCIEvents()
+}
+
+// writeUpgradeReport writes the report to the node home. Failures are only
+// logged, the file is not part of the consensus.
+func (app *OraichainApp) writeUpgradeReport(ctx sdk.Context, report *upgrades.Report) {
+	if err := report.WriteFile(app.homePath); err != nil {
+		ctx.Logger().Error("failed to write upgrade report", "upgrade", report.UpgradeName, "err", err)
+		return
+	}
+	ctx.Logger().Info("upgrade report written", "path", upgrades.ReportPath(app.homePath, report.UpgradeName))
+}
+
+// storeHashes returns the last commit hash of every mounted store, sorted by
+// store name, as the before hashes of a report.
+func (app *OraichainApp) storeHashes() []upgrades.StoreHash {
+	names := app.storeNames()
+	hashes := make([]upgrades.StoreHash, 0, len(names))
+	for _, name := range names {
+		commitID := app.CommitMultiStore().GetCommitKVStore(app.keys[name]).LastCommitID()
+		hashes = append(hashes, upgrades.StoreHash{Store: name, Before: hex.EncodeToString(commitID.Hash)})
+	}
+	return hashes
+}
+
+// checkInvariants runs every crisis invariant without halting on broken ones.
+func (app *OraichainApp) checkInvariants(ctx sdk.Context) []upgrades.InvariantCheck {
+	var checks []upgrades.InvariantCheck
+	for _, route := range app.crisisKeeper.Routes() {
+		msg, broken := route.Invar(ctx)
+		check := upgrades.InvariantCheck{Route: route.FullRoute(), Broken: broken}
+		if broken {
+			check.Message = msg
+		}
+		checks = append(checks, check)
+	}
+	return checks
+}
+
+// findUpgrade returns the registered upgrade with the given name
+func findUpgrade(name string) (upgrades.Upgrade, bool) {
+	for _, upgrade := range Upgrades {
+		if upgrade.UpgradeName == name {
+			return upgrade, true
+		}
+	}
+	return upgrades.Upgrade{}, false
+}
+
+// storeNames returns the names of the mounted KV stores, sorted
+func (app *OraichainApp) storeNames() []string {
+	names := make([]string, 0, len(app.keys))
+	for name := range app.keys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// upgradeKeepers returns the keepers passed to the upgrade handlers.
+func (app *OraichainApp) upgradeKeepers() *upgrades.AppKeepers {
+	return &upgrades.AppKeepers{
+		IBCKeeper:           app.ibcKeeper,
+		ICAAuthKeeper:       app.ICAAuthKeeper,
+		ScopedInterTxKeeper: app.scopedInterTxKeeper,
+	}
+}
+
+// pendingUpgrade returns the upgrade written to disk by the previous binary
+// when it halted at the upgrade height, unless that height is skipped.
+func (app *OraichainApp) pendingUpgrade() (upgrades.Upgrade, int64, bool) {
+	upgradeInfo, err := app.upgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(err)
+	}
+
+	if app.upgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return upgrades.Upgrade{}, 0, false
+	}
+
+	upgrade, found := findUpgrade(upgradeInfo.Name)
+	return upgrade, upgradeInfo.Height, found
+}
+
+func (app *OraichainApp) isMountedStore(name string) bool {
+	_, ok := app.keys[name]
+	return ok
+}
+
+// commitInfoKeyFmt is the key of the commit info of a version in the
+// database of the root multistore
+const commitInfoKeyFmt = "s/%d"
+
+// committedStores returns the latest committed version in the database of
+// the root multistore and the names of the stores committed at that version.
+func committedStores(db dbm.DB) (int64, map[string]bool, error) {
+	committed := map[string]bool{}
+	version := rootmulti.GetLatestVersion(db)
+	if version == 0 {
+		return 0, committed, nil
+	}
+
+	bz, err := db.Get([]byte(fmt.Sprintf(commitInfoKeyFmt, version)))
+	if err != nil || bz == nil {
+		return 0, nil, fmt.Errorf("no commit info found at height %d", version)
+	}
+	var commitInfo storetypes.CommitInfo
+	if err := commitInfo.Unmarshal(bz); err != nil {
+		return 0, nil, err
+	}
+
+	for _, storeInfo := range commitInfo.StoreInfos {
+		committed[storeInfo.Name] = true
+	}
+	return version, committed, nil
+}
+
+// validateMountedStores fails when a store mounted by the app is missing
+// from the last commit of the chain, unless the pending upgrade adds it at
+// the next height. Otherwise the app fails to load with a version mismatch,
+// typically because the upgrade adding the store does not declare it.
+func (app *OraichainApp) validateMountedStores(db dbm.DB) error {
+	version, committed, err := committedStores(db)
+	if err != nil || version == 0 {
+		return err
+	}
+
+	if upgrade, height, found := app.pendingUpgrade(); found && height == version+1 {
+		for _, name := range upgrade.StoreUpgrades.Added {
+			committed[name] = true
+		}
+		for _, rename := range upgrade.StoreUpgrades.Renamed {
+			committed[rename.NewKey] = true
+		}
+	}
+
+	for _, name := range app.storeNames() {
+		if !committed[name] {
+			return fmt.Errorf("store %s is mounted but has no data at height %d, declare it in the store upgrades of the upgrade adding it", name, version)
+		}
+	}
+
+	return nil
+}
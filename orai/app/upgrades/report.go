@@ -0,0 +1,258 @@
+package upgrades
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// ModuleVersionChange is the consensus version of a module before and after
+// an upgrade. From is zero for modules added by the upgrade.
+type ModuleVersionChange struct {
+	Module string `json:"module"`
+	From   uint64 `json:"from"`
+	To     uint64 `json:"to"`
+}
+
+// Changed reports whether the upgrade migrated or added the module.
+func (c ModuleVersionChange) Changed() bool {
+	return c.From != c.To
+}
+
+// DiffVersionMaps returns the version change of every module in either map,
+// sorted by module name.
+func DiffVersionMaps(from, to module.VersionMap) []ModuleVersionChange {
+	names := map[string]bool{}
+	for name := range from {
+		names[name] = true
+	}
+	for name := range to {
+		names[name] = true
+	}
+
+	changes := make([]ModuleVersionChange, 0, len(names))
+	for name := range names {
+		changes = append(changes, ModuleVersionChange{Module: name, From: from[name], To: to[name]})
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Module < changes[j].Module })
+
+	return changes
+}
+
+// StoreSize is the number of entries of a store and the bytes of their keys
+// and values.
+type StoreSize struct {
+	Keys  int64 `json:"keys"`
+	Bytes int64 `json:"bytes"`
+}
+
+// MeasureStore iterates the whole store to compute its size.
+func MeasureStore(store sdk.KVStore) StoreSize {
+	var size StoreSize
+
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		size.Keys++
+		size.Bytes += int64(len(it.Key()) + len(it.Value()))
+	}
+
+	return size
+}
+
+// Report events are emitted when applying an upgrade. Durations differ
+// between nodes, they are fine in begin block events which are not part of
+// the results hash.
+const (
+	EventTypeUpgradeMigration = "upgrade_migration"
+	EventTypeUpgradeInvariant = "upgrade_invariant"
+	EventTypeUpgradeStoreHash = "upgrade_store_hash"
+
+	AttributeKeyUpgrade     = "upgrade"
+	AttributeKeyModule      = "module"
+	AttributeKeyFromVersion = "from_version"
+	AttributeKeyToVersion   = "to_version"
+	AttributeKeyDuration    = "duration"
+	AttributeKeyRoute       = "route"
+	AttributeKeyBroken      = "broken"
+	AttributeKeyStore       = "store"
+	AttributeKeyBefore      = "before"
+	AttributeKeyAfter       = "after"
+)
+
+// Report describes how an upgrade changed the state, for operators to
+// compare across validators.
+type Report struct {
+	UpgradeName string            `json:"upgrade_name"`
+	Height      int64             `json:"height"`
+	Migrations  []ModuleMigration `json:"migrations"`
+	Invariants  []InvariantCheck  `json:"invariants"`
+	StoreHashes []StoreHash       `json:"store_hashes"`
+}
+
+// ModuleMigration is the version change of a module and the time spent
+// migrating it.
+type ModuleMigration struct {
+	ModuleVersionChange
+	Duration time.Duration `json:"duration_ns"`
+}
+
+// InvariantCheck is the result of an invariant checked after the upgrade.
+type InvariantCheck struct {
+	Route   string `json:"route"`
+	Broken  bool   `json:"broken"`
+	Message string `json:"message,omitempty"`
+}
+
+// StoreHash is the hex encoded commit hash of a store at the height before
+// the upgrade and at the upgrade height. Before is empty for added stores,
+// after is only known once the upgrade height is committed.
+type StoreHash struct {
+	Store  string `json:"store"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// BrokenInvariants returns the messages of the broken invariants.
+func (r Report) BrokenInvariants() []string {
+	var msgs []string
+	for _, check := range r.Invariants {
+		if check.Broken {
+			msgs = append(msgs, check.Message)
+		}
+	}
+	return msgs
+}
+
+// MigrationEvents returns an event per migrated module and per checked
+// invariant.
+func (r Report) MigrationEvents() sdk.Events {
+	events := make(sdk.Events, 0, len(r.Migrations)+len(r.Invariants))
+	for _, migration := range r.Migrations {
+		events = append(events, sdk.NewEvent(
+			EventTypeUpgradeMigration,
+			sdk.NewAttribute(AttributeKeyUpgrade, r.UpgradeName),
+			sdk.NewAttribute(AttributeKeyModule, migration.Module),
+			sdk.NewAttribute(AttributeKeyFromVersion, strconv.FormatUint(migration.From, 10)),
+			sdk.NewAttribute(AttributeKeyToVersion, strconv.FormatUint(migration.To, 10)),
+			sdk.NewAttribute(AttributeKeyDuration, migration.Duration.String()),
+		))
+	}
+	for _, check := range r.Invariants {
+		events = append(events, sdk.NewEvent(
+			EventTypeUpgradeInvariant,
+			sdk.NewAttribute(AttributeKeyUpgrade, r.UpgradeName),
+			sdk.NewAttribute(AttributeKeyRoute, check.Route),
+			sdk.NewAttribute(AttributeKeyBroken, strconv.FormatBool(check.Broken)),
+		))
+	}
+	return events
+}
+
+// StoreHashEvents returns an event per store.
+func (r Report) StoreHashEvents() sdk.Events {
+	events := make(sdk.Events, 0, len(r.StoreHashes))
+	for _, hash := range r.StoreHashes {
+		events = append(events, sdk.NewEvent(
+			EventTypeUpgradeStoreHash,
+			sdk.NewAttribute(AttributeKeyUpgrade, r.UpgradeName),
+			sdk.NewAttribute(AttributeKeyStore, hash.Store),
+			sdk.NewAttribute(AttributeKeyBefore, hash.Before),
+			sdk.NewAttribute(AttributeKeyAfter, hash.After),
+		))
+	}
+	return events
+}
+
+// ReportPath returns the path of the report file of an upgrade, next to the
+// upgrade info file in the data directory of the node home.
+func ReportPath(homePath, upgradeName string) string {
+	return filepath.Join(homePath, "data", fmt.Sprintf("upgrade-report-%s.json", upgradeName))
+}
+
+// WriteFile writes the report as indented JSON to its path in the node home.
+func (r Report) WriteFile(homePath string) error {
+	bz, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	path := ReportPath(homePath, r.UpgradeName)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, bz, 0o600)
+}
+
+// ReadReportFile reads the report of an upgrade from its path in the node
+// home.
+func ReadReportFile(homePath, upgradeName string) (*Report, error) {
+	bz, err := os.ReadFile(ReportPath(homePath, upgradeName))
+	if err != nil {
+		return nil, err
+	}
+
+	var report Report
+	if err := json.Unmarshal(bz, &report); err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+type reportContextKey struct{}
+
+// WithReport attaches the report of the upgrade being applied to the context
+// passed to its handler, for RunMigrations to record the migrations.
+func WithReport(ctx sdk.Context, report *Report) sdk.Context {
+	return ctx.WithValue(reportContextKey{}, report)
+}
+
+// RunMigrations runs the module migrations like module.Manager.RunMigrations,
+// one module at a time in the same order, to record the version change and
+// duration of each module in the report of the context, if any.
+func RunMigrations(ctx sdk.Context, mm *module.Manager, configurator module.Configurator, fromVM module.VersionMap) (module.VersionMap, error) {
+	report, _ := ctx.Value(reportContextKey{}).(*Report)
+
+	order := mm.OrderMigrations
+	if order == nil {
+		order = module.DefaultMigrationsOrder(mm.ModuleNames())
+	}
+
+	// every other module is at its consensus version, so the module manager
+	// only migrates or initializes the current one
+	currentVM := mm.GetVersionMap()
+	toVM := module.VersionMap{}
+	for _, name := range order {
+		moduleVM := module.VersionMap{}
+		for other, version := range currentVM {
+			moduleVM[other] = version
+		}
+		delete(moduleVM, name)
+		if version, ok := fromVM[name]; ok {
+			moduleVM[name] = version
+		}
+
+		start := time.Now()
+		updatedVM, err := mm.RunMigrations(ctx, configurator, moduleVM)
+		if err != nil {
+			return nil, err
+		}
+		toVM[name] = updatedVM[name]
+
+		if report != nil {
+			report.Migrations = append(report.Migrations, ModuleMigration{
+				ModuleVersionChange: ModuleVersionChange{Module: name, From: fromVM[name], To: toVM[name]},
+				Duration:            time.Since(start),
+			})
+		}
+	}
+
+	return toVM, nil
+}
@@ -0,0 +1,90 @@
+// Package upgrades declares the chain upgrades handled by the app. Every
+// release changing stores or state gets a sub-package declaring an Upgrade,
+// and the app registers all of them so that nodes replaying the chain can
+// apply each historical upgrade at its height.
+package upgrades
+
+import (
+	"fmt"
+	"sort"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	ibckeeper "github.com/cosmos/ibc-go/v4/modules/core/keeper"
+
+	icaauthkeeper "github.com/oraichain/orai/x/icaauth/keeper"
+)
+
+// AppKeepers are the keepers of the app available to upgrade handlers
+// migrating state beyond the module migrations.
+type AppKeepers struct {
+	IBCKeeper     *ibckeeper.Keeper
+	ICAAuthKeeper icaauthkeeper.Keeper
+
+	// ScopedInterTxKeeper owns the capabilities of the channels opened
+	// through the intertx module, replaced by icaauth in v0.42.0
+	ScopedInterTxKeeper capabilitykeeper.ScopedKeeper
+}
+
+// Upgrade defines a named chain upgrade.
+type Upgrade struct {
+	// UpgradeName is the name of the software upgrade plan
+	UpgradeName string
+
+	// CreateUpgradeHandler returns the handler run at the upgrade height
+	CreateUpgradeHandler func(mm *module.Manager, configurator module.Configurator, keepers *AppKeepers) upgradetypes.UpgradeHandler
+
+	// StoreUpgrades lists the stores added, renamed or deleted at the upgrade height
+	StoreUpgrades storetypes.StoreUpgrades
+}
+
+// CreateRunMigrationsHandler returns a handler that only runs the module
+// migrations, which also initializes the genesis of newly added modules.
+func CreateRunMigrationsHandler(mm *module.Manager, configurator module.Configurator, _ *AppKeepers) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		ctx.Logger().Info("start to migrate modules...", "upgrade", plan.Name, "versions", fromVM)
+		return RunMigrations(ctx, mm, configurator, fromVM)
+	}
+}
+
+// ValidateStoreUpgrades replays the store upgrades in order and checks that
+// every store left added or renamed to is mounted, and that every store left
+// deleted or renamed from is not. It also rejects a store added twice.
+func ValidateStoreUpgrades(upgrades []Upgrade, isMounted func(name string) bool) error {
+	present := map[string]bool{}
+	for _, upgrade := range upgrades {
+		for _, name := range upgrade.StoreUpgrades.Added {
+			if present[name] {
+				return fmt.Errorf("upgrade %s adds store %s, which already exists", upgrade.UpgradeName, name)
+			}
+			present[name] = true
+		}
+		for _, rename := range upgrade.StoreUpgrades.Renamed {
+			present[rename.OldKey] = false
+			present[rename.NewKey] = true
+		}
+		for _, name := range upgrade.StoreUpgrades.Deleted {
+			present[name] = false
+		}
+	}
+
+	names := make([]string, 0, len(present))
+	for name := range present {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if want := present[name]; isMounted(name) != want {
+			if want {
+				return fmt.Errorf("store %s is added by an upgrade but not mounted", name)
+			}
+			return fmt.Errorf("store %s is deleted by an upgrade but still mounted", name)
+		}
+	}
+
+	return nil
+}
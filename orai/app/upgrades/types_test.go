@@ -0,0 +1,65 @@
+package upgrades_test
+
+import (
+	"testing"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oraichain/orai/app/upgrades"
+)
+
+func TestValidateStoreUpgrades(t *testing.T) {
+	mounted := map[string]bool{"bank": true, "sponsor": true, "clock": true}
+	isMounted := func(name string) bool { return mounted[name] }
+
+	cases := map[string]struct {
+		storeUpgrades []storetypes.StoreUpgrades
+		expErr        bool
+	}{
+		"no store upgrades": {
+			storeUpgrades: []storetypes.StoreUpgrades{{}},
+		},
+		"added and mounted": {
+			storeUpgrades: []storetypes.StoreUpgrades{{Added: []string{"sponsor"}}},
+		},
+		"added but not mounted": {
+			storeUpgrades: []storetypes.StoreUpgrades{{Added: []string{"intertx"}}},
+			expErr:        true,
+		},
+		"added twice": {
+			storeUpgrades: []storetypes.StoreUpgrades{{Added: []string{"sponsor"}}, {Added: []string{"sponsor"}}},
+			expErr:        true,
+		},
+		"added then deleted": {
+			storeUpgrades: []storetypes.StoreUpgrades{{Added: []string{"intertx"}}, {Deleted: []string{"intertx"}}},
+		},
+		"deleted but mounted": {
+			storeUpgrades: []storetypes.StoreUpgrades{{Deleted: []string{"bank"}}},
+			expErr:        true,
+		},
+		"renamed": {
+			storeUpgrades: []storetypes.StoreUpgrades{{Renamed: []storetypes.StoreRename{{OldKey: "cron", NewKey: "clock"}}}},
+		},
+		"renamed from a mounted store": {
+			storeUpgrades: []storetypes.StoreUpgrades{{Renamed: []storetypes.StoreRename{{OldKey: "bank", NewKey: "clock"}}}},
+			expErr:        true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var list []upgrades.Upgrade
+			for _, storeUpgrades := range tc.storeUpgrades {
+				list = append(list, upgrades.Upgrade{UpgradeName: name, StoreUpgrades: storeUpgrades})
+			}
+
+			err := upgrades.ValidateStoreUpgrades(list, isMounted)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package v0417
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+
+	"github.com/oraichain/orai/app/upgrades"
+)
+
+// UpgradeName defines the on-chain upgrade name for the v0.41.7 upgrade.
+const UpgradeName = "v0.41.7"
+
+// Upgrade only runs the module migrations, no store changed in this release.
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: upgrades.CreateRunMigrationsHandler,
+	StoreUpgrades: storetypes.StoreUpgrades{
+		Added: []string{},
+	},
+}
@@ -0,0 +1,60 @@
+package v0420
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/oraichain/orai/app/upgrades"
+	feesharetypes "github.com/oraichain/orai/x/feeshare/types"
+	icqtypes "github.com/oraichain/orai/x/icq/types"
+	msgfiltertypes "github.com/oraichain/orai/x/msgfilter/types"
+	ratelimittypes "github.com/oraichain/orai/x/ratelimit/types"
+	sponsortypes "github.com/oraichain/orai/x/sponsor/types"
+	tokenfactorytypes "github.com/oraichain/orai/x/tokenfactory/types"
+)
+
+const (
+	// UpgradeName defines the on-chain upgrade name for the v0.42.0 upgrade.
+	UpgradeName = "v0.42.0"
+
+	// InterTxModuleName is the name of the demo interchain accounts
+	// authentication module replaced by icaauth, which owned a store and
+	// the capabilities of the interchain account channels.
+	InterTxModuleName = "intertx"
+)
+
+// Upgrade adds the stores of the sponsor, msgfilter, icq, tokenfactory,
+// feeshare and ratelimit modules and deletes the store of the intertx module.
+// The params only modules added in this release get their default params from
+// the module migrations.
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades: storetypes.StoreUpgrades{
+		Added:   []string{sponsortypes.StoreKey, msgfiltertypes.StoreKey, icqtypes.StoreKey, tokenfactorytypes.StoreKey, feesharetypes.StoreKey, ratelimittypes.StoreKey},
+		Deleted: []string{InterTxModuleName},
+	},
+}
+
+// CreateUpgradeHandler runs the module migrations, then hands the channels
+// of the interchain accounts registered through intertx over to icaauth so
+// that their owners keep submitting txs.
+func CreateUpgradeHandler(mm *module.Manager, configurator module.Configurator, keepers *upgrades.AppKeepers) upgradetypes.UpgradeHandler {
+	runMigrations := upgrades.CreateRunMigrationsHandler(mm, configurator, keepers)
+
+	return func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		toVM, err := runMigrations(ctx, plan, fromVM)
+		if err != nil {
+			return nil, err
+		}
+
+		channels := keepers.IBCKeeper.ChannelKeeper.GetAllChannels(ctx)
+		if err := keepers.ICAAuthKeeper.MigrateChannelCapabilities(ctx, keepers.ScopedInterTxKeeper, channels); err != nil {
+			return nil, err
+		}
+
+		return toVM, nil
+	}
+}
@@ -0,0 +1,153 @@
+package app
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/CosmWasm/wasmd/x/wasm"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	db "github.com/tendermint/tm-db"
+
+	"github.com/oraichain/orai/app/upgrades"
+)
+
+// ensure that every upgrade is declared once and gets a handler
+func TestUpgradeHandlersRegistered(t *testing.T) {
+	gapp := NewOraichainApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db.NewMemDB(), nil, true, map[int64]bool{}, t.TempDir(), 0, MakeEncodingConfig(), wasm.EnableAllProposals, EmptyAppOptions{}, emptyWasmOpts)
+
+	names := map[string]bool{}
+	for _, upgrade := range Upgrades {
+		require.False(t, names[upgrade.UpgradeName], "duplicate upgrade %s", upgrade.UpgradeName)
+		names[upgrade.UpgradeName] = true
+		require.True(t, gapp.upgradeKeeper.HasHandler(upgrade.UpgradeName), upgrade.UpgradeName)
+	}
+}
+
+// ensure that a store mounted after the chain started fails the startup
+// unless an upgrade adds it
+func TestValidateMountedStores(t *testing.T) {
+	home, memDB := t.TempDir(), db.NewMemDB()
+	gapp := NewOraichainApp(log.NewNopLogger(), memDB, nil, true, map[int64]bool{}, home, 0, MakeEncodingConfig(), wasm.EnableAllProposals, EmptyAppOptions{}, emptyWasmOpts)
+	stateBytes, err := json.Marshal(NewDefaultGenesisState(gapp.appCodec))
+	require.NoError(t, err)
+	gapp.InitChain(abci.RequestInitChain{AppStateBytes: stateBytes})
+	gapp.Commit()
+
+	restart := func(extraKeys ...string) error {
+		gapp := NewOraichainApp(log.NewNopLogger(), memDB, nil, false, map[int64]bool{}, home, 0, MakeEncodingConfig(), wasm.EnableAllProposals, EmptyAppOptions{}, emptyWasmOpts)
+		for _, name := range extraKeys {
+			key := sdk.NewKVStoreKey(name)
+			gapp.keys[name] = key
+			gapp.MountStore(key, sdk.StoreTypeIAVL)
+		}
+		return gapp.validateMountedStores(memDB)
+	}
+
+	require.NoError(t, restart())
+	require.ErrorContains(t, restart("undeclared"), "store undeclared is mounted but has no data at height 1")
+
+}
+
+// ensure that dry runs apply the upgrade handler on top of the latest height
+// without committing anything
+func TestDryRunUpgrade(t *testing.T) {
+	home, memDB := t.TempDir(), db.NewMemDB()
+	gapp := NewOraichainApp(log.NewNopLogger(), memDB, nil, true, map[int64]bool{}, home, 0, MakeEncodingConfig(), wasm.EnableAllProposals, EmptyAppOptions{}, emptyWasmOpts)
+	stateBytes, err := json.Marshal(NewDefaultGenesisState(gapp.appCodec))
+	require.NoError(t, err)
+	gapp.InitChain(abci.RequestInitChain{AppStateBytes: stateBytes})
+	gapp.Commit()
+
+	dryRun := func(name string) (UpgradeDryRun, error) {
+		gapp := NewOraichainApp(log.NewNopLogger(), memDB, nil, false, map[int64]bool{}, home, 0, MakeEncodingConfig(), wasm.EnableAllProposals, EmptyAppOptions{}, emptyWasmOpts)
+		return gapp.DryRunUpgrade(memDB, name, "dry-run-test")
+	}
+
+	_, err = dryRun("unknown")
+	require.ErrorContains(t, err, "no upgrade named unknown is registered")
+
+	for _, upgrade := range Upgrades {
+		report, err := dryRun(upgrade.UpgradeName)
+		require.NoError(t, err, upgrade.UpgradeName)
+		require.Equal(t, int64(2), report.Height)
+		require.Empty(t, report.BrokenInvariants)
+		require.Len(t, report.Stores, len(gapp.keys))
+		for _, change := range report.Versions {
+			require.False(t, change.Changed(), change.Module)
+		}
+	}
+
+	require.Equal(t, int64(1), rootmulti.GetLatestVersion(memDB))
+}
+
+// ensure that applying an upgrade writes a report completed with the store
+// hashes once the upgrade height is committed, even across a restart, and
+// that broken invariants are reported without failing the upgrade
+func TestUpgradeReport(t *testing.T) {
+	home, memDB := t.TempDir(), db.NewMemDB()
+	gapp := NewOraichainApp(log.NewNopLogger(), memDB, nil, true, map[int64]bool{}, home, 0, MakeEncodingConfig(), wasm.EnableAllProposals, EmptyAppOptions{}, emptyWasmOpts)
+	stateBytes, err := json.Marshal(NewDefaultGenesisState(gapp.appCodec))
+	require.NoError(t, err)
+	gapp.InitChain(abci.RequestInitChain{AppStateBytes: stateBytes})
+	gapp.Commit()
+	gapp.crisisKeeper.RegisterRoute("test", "broken", func(sdk.Context) (string, bool) {
+		return "always broken", true
+	})
+
+	upgradeName := Upgrades[0].UpgradeName
+	header := tmproto.Header{Height: gapp.LastBlockHeight() + 1, Time: time.Now()}
+	gapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := gapp.NewContext(false, header)
+	gapp.upgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: upgradeName, Height: header.Height})
+	require.NotEmpty(t, eventsOfType(ctx.EventManager().ABCIEvents(), upgrades.EventTypeUpgradeMigration))
+	require.NotEmpty(t, eventsOfType(ctx.EventManager().ABCIEvents(), upgrades.EventTypeUpgradeInvariant))
+	gapp.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	gapp.Commit()
+
+	readReport := func() upgrades.Report {
+		bz, err := os.ReadFile(upgrades.ReportPath(home, upgradeName))
+		require.NoError(t, err)
+		var report upgrades.Report
+		require.NoError(t, json.Unmarshal(bz, &report))
+		return report
+	}
+
+	report := readReport()
+	require.Equal(t, header.Height, report.Height)
+	require.Len(t, report.Migrations, len(gapp.mm.Modules))
+	require.Len(t, report.StoreHashes, len(gapp.keys))
+	require.Equal(t, []string{"always broken"}, report.BrokenInvariants())
+	for _, hash := range report.StoreHashes {
+		require.Empty(t, hash.After, hash.Store)
+	}
+
+	// the node restarts before the next height
+	gapp = NewOraichainApp(log.NewNopLogger(), memDB, nil, true, map[int64]bool{}, home, 0, MakeEncodingConfig(), wasm.EnableAllProposals, EmptyAppOptions{}, emptyWasmOpts)
+	header = tmproto.Header{Height: gapp.LastBlockHeight() + 1, Time: time.Now()}
+	res := gapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	require.Len(t, eventsOfType(res.Events, upgrades.EventTypeUpgradeStoreHash), len(gapp.keys))
+
+	report = readReport()
+	for _, hash := range report.StoreHashes {
+		require.NotEmpty(t, hash.Before, hash.Store)
+		require.NotEmpty(t, hash.After, hash.Store)
+	}
+}
+
+func eventsOfType(events []abci.Event, eventType string) []abci.Event {
+	var found []abci.Event
+	for _, event := range events {
+		if event.Type == eventType {
+			found = append(found, event)
+		}
+	}
+	return found
+}
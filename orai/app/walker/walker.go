@@ -0,0 +1,151 @@
+// Package walker flattens the messages of a transaction so that ante checks
+// also apply to messages wrapped inside other messages, such as authz
+// executions and interchain account host packets.
+package walker
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+)
+
+// DefaultMaxDepth is the number of wrapper levels a transaction may nest
+// before it is rejected. A plain MsgExec is depth 1.
+const DefaultMaxDepth = 3
+
+// Unwrapper returns the messages wrapped by msg. ok is false when msg is not a
+// wrapper handled by the unwrapper.
+type Unwrapper func(cdc codec.BinaryCodec, msg sdk.Msg) (inner []sdk.Msg, ok bool, err error)
+
+// VisitFunc is called for every message, wrappers included. depth is 0 for
+// messages at the top level of the tx.
+type VisitFunc func(msg sdk.Msg, depth int) error
+
+// Walker visits the messages of a transaction recursively.
+type Walker struct {
+	cdc        codec.BinaryCodec
+	maxDepth   int
+	unwrappers []Unwrapper
+}
+
+// New returns a walker descending into authz MsgExec and interchain account
+// host packets, up to maxDepth levels.
+func New(cdc codec.BinaryCodec, maxDepth int) Walker {
+	return NewWithUnwrappers(cdc, maxDepth, UnwrapAuthzExec, UnwrapICAHostPacket)
+}
+
+// NewWithUnwrappers returns a walker only descending into the wrappers handled
+// by the given unwrappers, up to maxDepth levels.
+func NewWithUnwrappers(cdc codec.BinaryCodec, maxDepth int, unwrappers ...Unwrapper) Walker {
+	return Walker{
+		cdc:        cdc,
+		maxDepth:   maxDepth,
+		unwrappers: unwrappers,
+	}
+}
+
+// WithUnwrappers returns a copy of the walker that also descends into the
+// wrappers handled by the given unwrappers.
+func (w Walker) WithUnwrappers(unwrappers ...Unwrapper) Walker {
+	w.unwrappers = append(append([]Unwrapper{}, w.unwrappers...), unwrappers...)
+	return w
+}
+
+// MaxDepth returns the maximum nesting depth accepted by the walker.
+func (w Walker) MaxDepth() int {
+	return w.maxDepth
+}
+
+// Walk calls fn for every message in msgs and, recursively, for every message
+// wrapped inside them. It stops at the first error.
+func (w Walker) Walk(msgs []sdk.Msg, fn VisitFunc) error {
+	return w.walk(msgs, 0, fn)
+}
+
+func (w Walker) walk(msgs []sdk.Msg, depth int, fn VisitFunc) error {
+	if depth > w.maxDepth {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "messages are nested deeper than %d levels", w.maxDepth)
+	}
+
+	for _, msg := range msgs {
+		if err := fn(msg, depth); err != nil {
+			return err
+		}
+
+		inner, err := w.unwrap(msg)
+		if err != nil {
+			return err
+		}
+		if len(inner) == 0 {
+			continue
+		}
+
+		if err := w.walk(inner, depth+1, fn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (w Walker) unwrap(msg sdk.Msg) ([]sdk.Msg, error) {
+	for _, unwrapper := range w.unwrappers {
+		inner, ok, err := unwrapper(w.cdc, msg)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return inner, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// UnwrapAuthzExec returns the messages executed by an authz MsgExec.
+func UnwrapAuthzExec(cdc codec.BinaryCodec, msg sdk.Msg) ([]sdk.Msg, bool, error) {
+	execMsg, ok := msg.(*authz.MsgExec)
+	if !ok {
+		return nil, false, nil
+	}
+
+	inner := make([]sdk.Msg, len(execMsg.Msgs))
+	for i, v := range execMsg.Msgs {
+		var innerMsg sdk.Msg
+		if err := cdc.UnpackAny(v, &innerMsg); err != nil {
+			return nil, true, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "cannot unmarshal authz exec msgs")
+		}
+		inner[i] = innerMsg
+	}
+
+	return inner, true, nil
+}
+
+// UnwrapICAHostPacket returns the messages an interchain account host will
+// execute when relaying a MsgRecvPacket to the icahost port. Packets that
+// cannot be decoded are treated as leaves: the host module rejects them with
+// an error acknowledgement, so relaying them must not fail here.
+func UnwrapICAHostPacket(cdc codec.BinaryCodec, msg sdk.Msg) ([]sdk.Msg, bool, error) {
+	recvMsg, ok := msg.(*channeltypes.MsgRecvPacket)
+	if !ok || recvMsg.Packet.GetDestPort() != icatypes.PortID {
+		return nil, false, nil
+	}
+
+	var data icatypes.InterchainAccountPacketData
+	if err := icatypes.ModuleCdc.UnmarshalJSON(recvMsg.Packet.GetData(), &data); err != nil {
+		return nil, true, nil
+	}
+	if data.Type != icatypes.EXECUTE_TX {
+		return nil, true, nil
+	}
+
+	inner, err := icatypes.DeserializeCosmosTx(cdc, data.Data)
+	if err != nil {
+		return nil, true, nil
+	}
+
+	return inner, true, nil
+}
@@ -0,0 +1,149 @@
+package walker_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oraichain/orai/app/walker"
+)
+
+func makeCodec() codec.BinaryCodec {
+	registry := codectypes.NewInterfaceRegistry()
+	authz.RegisterInterfaces(registry)
+	banktypes.RegisterInterfaces(registry)
+	stakingtypes.RegisterInterfaces(registry)
+	return codec.NewProtoCodec(registry)
+}
+
+func TestWalk(t *testing.T) {
+	cdc := makeCodec()
+	addr := sdk.AccAddress("addr1_______________")
+	send := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("orai", 1)))
+	rate := sdk.ZeroDec()
+	edit := stakingtypes.NewMsgEditValidator(sdk.ValAddress(addr), stakingtypes.Description{}, &rate, nil)
+
+	exec := func(msgs ...sdk.Msg) sdk.Msg {
+		msg := authz.NewMsgExec(addr, msgs)
+		return &msg
+	}
+	icaPacket := func(port string, data []byte) sdk.Msg {
+		packet := channeltypes.NewPacket(data, 1, "icacontroller-owner", "channel-0", port, "channel-1", clienttypes.NewHeight(0, 100), 0)
+		return channeltypes.NewMsgRecvPacket(packet, nil, clienttypes.NewHeight(0, 1), addr.String())
+	}
+	icaTx := func(msgs ...sdk.Msg) []byte {
+		bz, err := icatypes.SerializeCosmosTx(cdc, msgs)
+		require.NoError(t, err)
+		return icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: bz}.GetBytes()
+	}
+
+	cases := map[string]struct {
+		msgs      []sdk.Msg
+		expDepths []int
+		expErr    bool
+	}{
+		"plain msgs": {
+			msgs:      []sdk.Msg{send, edit},
+			expDepths: []int{0, 0},
+		},
+		"authz exec": {
+			msgs:      []sdk.Msg{exec(send, edit)},
+			expDepths: []int{0, 1, 1},
+		},
+		"nested authz exec": {
+			msgs:      []sdk.Msg{exec(exec(edit))},
+			expDepths: []int{0, 1, 2},
+		},
+		"authz exec at max depth": {
+			msgs:      []sdk.Msg{exec(exec(exec(edit)))},
+			expDepths: []int{0, 1, 2, 3},
+		},
+		"authz exec nested too deep": {
+			msgs:   []sdk.Msg{exec(exec(exec(exec(edit))))},
+			expErr: true,
+		},
+		"ica host packet": {
+			msgs:      []sdk.Msg{icaPacket(icatypes.PortID, icaTx(send, edit))},
+			expDepths: []int{0, 1, 1},
+		},
+		"authz exec inside ica host packet": {
+			msgs:      []sdk.Msg{icaPacket(icatypes.PortID, icaTx(exec(edit)))},
+			expDepths: []int{0, 1, 2},
+		},
+		"packet to another port": {
+			msgs:      []sdk.Msg{icaPacket("transfer", icaTx(edit))},
+			expDepths: []int{0},
+		},
+		"malformed ica host packet": {
+			msgs:      []sdk.Msg{icaPacket(icatypes.PortID, []byte("not json"))},
+			expDepths: []int{0},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var depths []int
+			err := walker.New(cdc, walker.DefaultMaxDepth).Walk(tc.msgs, func(_ sdk.Msg, depth int) error {
+				depths = append(depths, depth)
+				return nil
+			})
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expDepths, depths)
+		})
+	}
+}
+
+func TestWalkStopsOnError(t *testing.T) {
+	cdc := makeCodec()
+	addr := sdk.AccAddress("addr1_______________")
+	rate := sdk.ZeroDec()
+	edit := stakingtypes.NewMsgEditValidator(sdk.ValAddress(addr), stakingtypes.Description{}, &rate, nil)
+	exec := authz.NewMsgExec(addr, []sdk.Msg{edit})
+
+	expErr := errors.New("rejected")
+	err := walker.New(cdc, walker.DefaultMaxDepth).Walk([]sdk.Msg{&exec}, func(msg sdk.Msg, _ int) error {
+		if _, ok := msg.(*stakingtypes.MsgEditValidator); ok {
+			return expErr
+		}
+		return nil
+	})
+	require.ErrorIs(t, err, expErr)
+}
+
+func TestWithUnwrappers(t *testing.T) {
+	cdc := makeCodec()
+	addr := sdk.AccAddress("addr1_______________")
+	send := banktypes.NewMsgSend(addr, addr, nil)
+	inner := banktypes.NewMsgSend(addr, sdk.AccAddress("addr2_______________"), nil)
+
+	// pretend every send to itself wraps another send
+	unwrapSelfSend := func(_ codec.BinaryCodec, msg sdk.Msg) ([]sdk.Msg, bool, error) {
+		m, ok := msg.(*banktypes.MsgSend)
+		if !ok || m.FromAddress != m.ToAddress {
+			return nil, false, nil
+		}
+		return []sdk.Msg{inner}, true, nil
+	}
+
+	var visited int
+	err := walker.New(cdc, walker.DefaultMaxDepth).WithUnwrappers(unwrapSelfSend).Walk([]sdk.Msg{send}, func(_ sdk.Msg, _ int) error {
+		visited++
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, visited)
+}
@@ -0,0 +1,309 @@
+// Package wasmbinding exposes chain features to contracts through the custom
+// msgs and queries of CosmWasm. The JSON of each variant mirrors the enums of
+// the contract side bindings, described by the JSON schema of SchemaVersion.
+package wasmbinding
+
+// OraiMsg is the custom msg of a contract. Exactly one variant is set.
+type OraiMsg struct {
+	// RegisterInterchainAccount opens an interchain account owned by the
+	// contract on the host chain of the connection
+	RegisterInterchainAccount *RegisterInterchainAccount `json:"register_interchain_account,omitempty"`
+
+	// SubmitInterchainTx executes msgs with the interchain account of the
+	// contract. The contract is called back with the result, see
+	// icaauthtypes.SudoMsg.
+	SubmitInterchainTx *SubmitInterchainTx `json:"submit_interchain_tx,omitempty"`
+
+	// SendInterchainQuery queries the host chain of the channel. The contract
+	// is called back with the responses, see icqtypes.SudoMsg.
+	SendInterchainQuery *SendInterchainQuery `json:"send_interchain_query,omitempty"`
+
+	// CreateDenom creates the denom factory/{contract}/{subdenom} with the
+	// contract as admin, paying the creation fee
+	CreateDenom *CreateDenom `json:"create_denom,omitempty"`
+
+	// MintTokens mints a denom administered by the contract
+	MintTokens *MintTokens `json:"mint_tokens,omitempty"`
+
+	// BurnTokens burns a denom administered by the contract, from the
+	// balance of the contract
+	BurnTokens *BurnTokens `json:"burn_tokens,omitempty"`
+
+	// ChangeAdmin changes the admin of a denom administered by the contract
+	ChangeAdmin *ChangeAdmin `json:"change_admin,omitempty"`
+
+	// SetMetadata sets the bank metadata of a denom administered by the
+	// contract
+	SetMetadata *SetMetadata `json:"set_metadata,omitempty"`
+
+	// SetBeforeSendHook sets the contract called before each send of a denom
+	// administered by the contract, see tokenfactorytypes.SudoMsg
+	SetBeforeSendHook *SetBeforeSendHook `json:"set_before_send_hook,omitempty"`
+}
+
+type RegisterInterchainAccount struct {
+	ConnectionID string `json:"connection_id"`
+	// Version is negotiated with the host chain when empty
+	Version string `json:"version,omitempty"`
+}
+
+type SubmitInterchainTx struct {
+	ConnectionID string `json:"connection_id"`
+	// Msgs are protobuf encoded msgs of the host chain
+	Msgs []ProtoMsg `json:"msgs"`
+	Memo string     `json:"memo,omitempty"`
+	// TimeoutSeconds is the time after the block time at which the tx
+	// times out
+	TimeoutSeconds uint64 `json:"timeout_seconds"`
+}
+
+type SendInterchainQuery struct {
+	ChannelID string `json:"channel_id"`
+	// Requests are gRPC queries or store reads of the host chain
+	Requests []QueryRequest `json:"requests"`
+	// TimeoutSeconds is the time after the block time at which the query
+	// times out
+	TimeoutSeconds uint64 `json:"timeout_seconds"`
+}
+
+// QueryRequest is a gRPC method with its protobuf encoded request, or a
+// /store/<store name>/key path with the key, base64 encoded in JSON.
+type QueryRequest struct {
+	Path string `json:"path"`
+	Data []byte `json:"data"`
+}
+
+// ProtoMsg is a protobuf Any, with a base64 encoded value in JSON.
+type ProtoMsg struct {
+	TypeURL string `json:"type_url"`
+	Value   []byte `json:"value"`
+}
+
+type RegisterInterchainAccountResponse struct {
+	PortID string `json:"port_id"`
+}
+
+type SubmitInterchainTxResponse struct {
+	ChannelID string `json:"channel_id"`
+	Sequence  uint64 `json:"sequence"`
+}
+
+type SendInterchainQueryResponse struct {
+	Sequence uint64 `json:"sequence"`
+}
+
+type CreateDenom struct {
+	Subdenom string `json:"subdenom"`
+}
+
+type MintTokens struct {
+	Denom string `json:"denom"`
+	// Amount is a Uint128 string
+	Amount string `json:"amount"`
+	// MintToAddress is the contract when empty
+	MintToAddress string `json:"mint_to_address,omitempty"`
+}
+
+type BurnTokens struct {
+	Denom string `json:"denom"`
+	// Amount is a Uint128 string
+	Amount string `json:"amount"`
+}
+
+type ChangeAdmin struct {
+	Denom string `json:"denom"`
+	// NewAdminAddress leaves the denom without admin when empty
+	NewAdminAddress string `json:"new_admin_address"`
+}
+
+type SetMetadata struct {
+	Metadata Metadata `json:"metadata"`
+}
+
+type SetBeforeSendHook struct {
+	Denom string `json:"denom"`
+	// Contract removes the hook when empty
+	Contract string `json:"contract"`
+}
+
+type CreateDenomResponse struct {
+	NewTokenDenom string `json:"new_token_denom"`
+}
+
+type MintTokensResponse struct{}
+
+type BurnTokensResponse struct{}
+
+type ChangeAdminResponse struct{}
+
+type SetMetadataResponse struct{}
+
+type SetBeforeSendHookResponse struct{}
+
+// OraiQuery is the custom query of a contract. Exactly one variant is set.
+type OraiQuery struct {
+	// InterchainAccountAddress returns the address of the interchain account
+	// of an owner on the host chain of the connection
+	InterchainAccountAddress *InterchainAccountAddress `json:"interchain_account_address,omitempty"`
+
+	// DenomMetadata returns the bank metadata of a denom
+	DenomMetadata *DenomMetadata `json:"denom_metadata,omitempty"`
+
+	// StakingPool returns the bonded and not bonded tokens
+	StakingPool *StakingPool `json:"staking_pool,omitempty"`
+
+	// DelegationRewards returns the rewards of a delegation not withdrawn yet
+	DelegationRewards *DelegationRewards `json:"delegation_rewards,omitempty"`
+
+	// DelegatorWithdrawAddress returns the address receiving the rewards of
+	// a delegator
+	DelegatorWithdrawAddress *DelegatorWithdrawAddress `json:"delegator_withdraw_address,omitempty"`
+
+	// IBCChannel returns a channel of any port, the contract is not required
+	// to own it
+	IBCChannel *IBCChannel `json:"ibc_channel,omitempty"`
+
+	// IBCHooksSender returns the address executing the contracts of the ibc
+	// hooks of the transfers received from a sender on a channel
+	IBCHooksSender *IBCHooksSender `json:"ibc_hooks_sender,omitempty"`
+
+	// FullDenom returns the denom created by an address with a subdenom
+	FullDenom *FullDenom `json:"full_denom,omitempty"`
+
+	// DenomAdmin returns the admin of a denom of the token factory
+	DenomAdmin *DenomAdmin `json:"denom_admin,omitempty"`
+
+	// DenomsByCreator returns the denoms created by an address
+	DenomsByCreator *DenomsByCreator `json:"denoms_by_creator,omitempty"`
+
+	// BeforeSendHook returns the before send hook contract of a denom
+	BeforeSendHook *BeforeSendHook `json:"before_send_hook,omitempty"`
+}
+
+type InterchainAccountAddress struct {
+	Owner        string `json:"owner"`
+	ConnectionID string `json:"connection_id"`
+}
+
+type InterchainAccountAddressResponse struct {
+	InterchainAccountAddress string `json:"interchain_account_address"`
+}
+
+type DenomMetadata struct {
+	Denom string `json:"denom"`
+}
+
+type DenomMetadataResponse struct {
+	Metadata Metadata `json:"metadata"`
+}
+
+// Metadata mirrors banktypes.Metadata.
+type Metadata struct {
+	Description string      `json:"description"`
+	DenomUnits  []DenomUnit `json:"denom_units"`
+	Base        string      `json:"base"`
+	Display     string      `json:"display"`
+	Name        string      `json:"name"`
+	Symbol      string      `json:"symbol"`
+}
+
+type DenomUnit struct {
+	Denom    string   `json:"denom"`
+	Exponent uint32   `json:"exponent"`
+	Aliases  []string `json:"aliases"`
+}
+
+type StakingPool struct{}
+
+type StakingPoolResponse struct {
+	// BondedTokens and NotBondedTokens are Uint128 strings of the bond denom
+	BondedTokens    string `json:"bonded_tokens"`
+	NotBondedTokens string `json:"not_bonded_tokens"`
+}
+
+type DelegationRewards struct {
+	Delegator string `json:"delegator"`
+	Validator string `json:"validator"`
+}
+
+type DelegationRewardsResponse struct {
+	Rewards []DecCoin `json:"rewards"`
+}
+
+// DecCoin is an amount with decimals, as a Decimal string.
+type DecCoin struct {
+	Denom  string `json:"denom"`
+	Amount string `json:"amount"`
+}
+
+type DelegatorWithdrawAddress struct {
+	Delegator string `json:"delegator"`
+}
+
+type DelegatorWithdrawAddressResponse struct {
+	WithdrawAddress string `json:"withdraw_address"`
+}
+
+type IBCChannel struct {
+	PortID    string `json:"port_id"`
+	ChannelID string `json:"channel_id"`
+}
+
+type IBCChannelResponse struct {
+	Channel Channel `json:"channel"`
+}
+
+// Channel mirrors channeltypes.Channel, with the proto names of the state
+// and ordering.
+type Channel struct {
+	State                 string   `json:"state"`
+	Ordering              string   `json:"ordering"`
+	CounterpartyPortID    string   `json:"counterparty_port_id"`
+	CounterpartyChannelID string   `json:"counterparty_channel_id"`
+	ConnectionHops        []string `json:"connection_hops"`
+	Version               string   `json:"version"`
+}
+
+type IBCHooksSender struct {
+	ChannelID      string `json:"channel_id"`
+	OriginalSender string `json:"original_sender"`
+}
+
+type IBCHooksSenderResponse struct {
+	Sender string `json:"sender"`
+}
+
+type FullDenom struct {
+	CreatorAddr string `json:"creator_addr"`
+	Subdenom    string `json:"subdenom"`
+}
+
+type FullDenomResponse struct {
+	Denom string `json:"denom"`
+}
+
+type DenomAdmin struct {
+	Denom string `json:"denom"`
+}
+
+type DenomAdminResponse struct {
+	// Admin is empty when the denom has no admin
+	Admin string `json:"admin"`
+}
+
+type DenomsByCreator struct {
+	Creator string `json:"creator"`
+}
+
+type DenomsByCreatorResponse struct {
+	Denoms []string `json:"denoms"`
+}
+
+type BeforeSendHook struct {
+	Denom string `json:"denom"`
+}
+
+type BeforeSendHookResponse struct {
+	// Contract is empty when the denom has no hook
+	Contract string `json:"contract"`
+}
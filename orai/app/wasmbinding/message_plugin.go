@@ -0,0 +1,219 @@
+package wasmbinding
+
+import (
+	"encoding/json"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	icaauthtypes "github.com/oraichain/orai/x/icaauth/types"
+	icqtypes "github.com/oraichain/orai/x/icq/types"
+	tokenfactorytypes "github.com/oraichain/orai/x/tokenfactory/types"
+)
+
+var _ wasmkeeper.Messenger = &CustomMessenger{}
+
+// CustomMessenger dispatches the custom msgs of contracts and passes the
+// other msgs to the wrapped messenger.
+type CustomMessenger struct {
+	wrapped wasmkeeper.Messenger
+	router  wasmkeeper.MessageRouter
+}
+
+// CustomMessageDecorator returns the decorator of the wasm messenger handling
+// the custom msgs. They go through the msg router like any other msg of a
+// contract, so the disabled msgs are rejected.
+func CustomMessageDecorator(router wasmkeeper.MessageRouter) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return &CustomMessenger{
+			wrapped: old,
+			router:  router,
+		}
+	}
+}
+
+// DispatchMsg implements wasmkeeper.Messenger. The data of a custom msg is the
+// JSON response of its variant.
+func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, error) {
+	if msg.Custom == nil {
+		return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+	}
+
+	sdkMsgs, err := EncodeOraiMsg(contractAddr, msg.Custom)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var events []sdk.Event
+	var data [][]byte
+	for _, sdkMsg := range sdkMsgs {
+		res, err := m.handleMsg(ctx, contractAddr, sdkMsg)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		bz, err := encodeResponse(sdkMsg, res.Data)
+		if err != nil {
+			return nil, nil, err
+		}
+		data = append(data, bz)
+		for _, ev := range res.Events {
+			events = append(events, sdk.Event(ev))
+		}
+	}
+	return events, data, nil
+}
+
+func (m *CustomMessenger) handleMsg(ctx sdk.Context, contractAddr sdk.AccAddress, msg sdk.Msg) (*sdk.Result, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	for _, signer := range msg.GetSigners() {
+		if !signer.Equals(contractAddr) {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "contract doesn't have permission")
+		}
+	}
+
+	handler := m.router.Handler(msg)
+	if handler == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "no handler for %s", sdk.MsgTypeURL(msg))
+	}
+	return handler(ctx, msg)
+}
+
+// encodeResponse converts the protobuf response of a msg to the JSON response
+// of its variant.
+func encodeResponse(msg sdk.Msg, data []byte) ([]byte, error) {
+	var resp interface{}
+	switch msg.(type) {
+	case *icaauthtypes.MsgRegisterAccount:
+		var res icaauthtypes.MsgRegisterAccountResponse
+		if err := res.Unmarshal(data); err != nil {
+			return nil, err
+		}
+		resp = RegisterInterchainAccountResponse{PortID: res.PortId}
+	case *icaauthtypes.MsgSubmitTx:
+		var res icaauthtypes.MsgSubmitTxResponse
+		if err := res.Unmarshal(data); err != nil {
+			return nil, err
+		}
+		resp = SubmitInterchainTxResponse{ChannelID: res.ChannelId, Sequence: res.Sequence}
+	case *icqtypes.MsgSendQuery:
+		var res icqtypes.MsgSendQueryResponse
+		if err := res.Unmarshal(data); err != nil {
+			return nil, err
+		}
+		resp = SendInterchainQueryResponse{Sequence: res.Sequence}
+	case *tokenfactorytypes.MsgCreateDenom:
+		var res tokenfactorytypes.MsgCreateDenomResponse
+		if err := res.Unmarshal(data); err != nil {
+			return nil, err
+		}
+		resp = CreateDenomResponse{NewTokenDenom: res.NewTokenDenom}
+	case *tokenfactorytypes.MsgMint:
+		resp = MintTokensResponse{}
+	case *tokenfactorytypes.MsgBurn:
+		resp = BurnTokensResponse{}
+	case *tokenfactorytypes.MsgChangeAdmin:
+		resp = ChangeAdminResponse{}
+	case *tokenfactorytypes.MsgSetDenomMetadata:
+		resp = SetMetadataResponse{}
+	case *tokenfactorytypes.MsgSetBeforeSendHook:
+		resp = SetBeforeSendHookResponse{}
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "no response for %s", sdk.MsgTypeURL(msg))
+	}
+	return json.Marshal(resp)
+}
+
+// EncodeOraiMsg converts the custom msg of a contract to the sdk msgs sent by
+// the contract.
+func EncodeOraiMsg(sender sdk.AccAddress, msg json.RawMessage) ([]sdk.Msg, error) {
+	var oraiMsg OraiMsg
+	if err := json.Unmarshal(msg, &oraiMsg); err != nil {
+		return nil, sdkerrors.Wrap(wasmtypes.ErrInvalidMsg, err.Error())
+	}
+
+	switch {
+	case oraiMsg.RegisterInterchainAccount != nil:
+		register := oraiMsg.RegisterInterchainAccount
+		return []sdk.Msg{icaauthtypes.NewMsgRegisterAccount(sender, register.ConnectionID, register.Version)}, nil
+	case oraiMsg.SubmitInterchainTx != nil:
+		submit := oraiMsg.SubmitInterchainTx
+		anys := make([]*codectypes.Any, len(submit.Msgs))
+		for i, msg := range submit.Msgs {
+			anys[i] = &codectypes.Any{TypeUrl: msg.TypeURL, Value: msg.Value}
+		}
+		return []sdk.Msg{&icaauthtypes.MsgSubmitTx{
+			Owner:        sender.String(),
+			ConnectionId: submit.ConnectionID,
+			Msgs:         anys,
+			Memo:         submit.Memo,
+			Timeout:      time.Duration(submit.TimeoutSeconds) * time.Second,
+		}}, nil
+	case oraiMsg.SendInterchainQuery != nil:
+		query := oraiMsg.SendInterchainQuery
+		reqs := make([]icqtypes.QueryRequest, len(query.Requests))
+		for i, req := range query.Requests {
+			reqs[i] = icqtypes.QueryRequest{Path: req.Path, Data: req.Data}
+		}
+		return []sdk.Msg{icqtypes.NewMsgSendQuery(sender, query.ChannelID, reqs, time.Duration(query.TimeoutSeconds)*time.Second)}, nil
+	case oraiMsg.CreateDenom != nil:
+		return []sdk.Msg{tokenfactorytypes.NewMsgCreateDenom(sender, oraiMsg.CreateDenom.Subdenom)}, nil
+	case oraiMsg.MintTokens != nil:
+		mint := oraiMsg.MintTokens
+		amount, err := parseCoin(mint.Denom, mint.Amount)
+		if err != nil {
+			return nil, err
+		}
+		return []sdk.Msg{tokenfactorytypes.NewMsgMint(sender, amount, mint.MintToAddress)}, nil
+	case oraiMsg.BurnTokens != nil:
+		burn := oraiMsg.BurnTokens
+		amount, err := parseCoin(burn.Denom, burn.Amount)
+		if err != nil {
+			return nil, err
+		}
+		return []sdk.Msg{tokenfactorytypes.NewMsgBurn(sender, amount)}, nil
+	case oraiMsg.ChangeAdmin != nil:
+		change := oraiMsg.ChangeAdmin
+		return []sdk.Msg{tokenfactorytypes.NewMsgChangeAdmin(sender, change.Denom, change.NewAdminAddress)}, nil
+	case oraiMsg.SetMetadata != nil:
+		return []sdk.Msg{tokenfactorytypes.NewMsgSetDenomMetadata(sender, toBankMetadata(oraiMsg.SetMetadata.Metadata))}, nil
+	case oraiMsg.SetBeforeSendHook != nil:
+		hook := oraiMsg.SetBeforeSendHook
+		return []sdk.Msg{tokenfactorytypes.NewMsgSetBeforeSendHook(sender, hook.Denom, hook.Contract)}, nil
+	default:
+		return nil, sdkerrors.Wrap(wasmtypes.ErrUnknownMsg, "unknown variant of orai msg")
+	}
+}
+
+// parseCoin parses the Uint128 string amount of a denom.
+func parseCoin(denom, amount string) (sdk.Coin, error) {
+	amt, ok := sdk.NewIntFromString(amount)
+	if !ok {
+		return sdk.Coin{}, sdkerrors.Wrapf(wasmtypes.ErrInvalidMsg, "invalid amount %s", amount)
+	}
+	return sdk.Coin{Denom: denom, Amount: amt}, nil
+}
+
+func toBankMetadata(metadata Metadata) banktypes.Metadata {
+	units := make([]*banktypes.DenomUnit, len(metadata.DenomUnits))
+	for i, unit := range metadata.DenomUnits {
+		units[i] = &banktypes.DenomUnit{Denom: unit.Denom, Exponent: unit.Exponent, Aliases: unit.Aliases}
+	}
+	return banktypes.Metadata{
+		Description: metadata.Description,
+		DenomUnits:  units,
+		Base:        metadata.Base,
+		Display:     metadata.Display,
+		Name:        metadata.Name,
+		Symbol:      metadata.Symbol,
+	}
+}
@@ -0,0 +1,175 @@
+package wasmbinding
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	icaauthtypes "github.com/oraichain/orai/x/icaauth/types"
+	icqtypes "github.com/oraichain/orai/x/icq/types"
+	tokenfactorytypes "github.com/oraichain/orai/x/tokenfactory/types"
+)
+
+func TestEncodeOraiMsg(t *testing.T) {
+	contract := sdk.AccAddress("contract____________")
+	denom := "factory/" + contract.String() + "/token"
+
+	tests := []struct {
+		name    string
+		msg     string
+		want    sdk.Msg
+		wantErr error
+	}{
+		{
+			name: "register interchain account",
+			msg:  `{"register_interchain_account":{"connection_id":"connection-0"}}`,
+			want: icaauthtypes.NewMsgRegisterAccount(contract, "connection-0", ""),
+		},
+		{
+			name: "submit interchain tx",
+			msg:  `{"submit_interchain_tx":{"connection_id":"connection-0","msgs":[{"type_url":"/host.v1.MsgDo","value":"AQI="}],"memo":"memo","timeout_seconds":600}}`,
+			want: &icaauthtypes.MsgSubmitTx{
+				Owner:        contract.String(),
+				ConnectionId: "connection-0",
+				Msgs:         []*codectypes.Any{{TypeUrl: "/host.v1.MsgDo", Value: []byte{1, 2}}},
+				Memo:         "memo",
+				Timeout:      10 * time.Minute,
+			},
+		},
+		{
+			name: "send interchain query",
+			msg:  `{"send_interchain_query":{"channel_id":"channel-0","requests":[{"path":"/store/bank/key","data":"AQI="}],"timeout_seconds":60}}`,
+			want: icqtypes.NewMsgSendQuery(contract, "channel-0", []icqtypes.QueryRequest{{Path: "/store/bank/key", Data: []byte{1, 2}}}, time.Minute),
+		},
+		{
+			name: "mint tokens",
+			msg:  `{"mint_tokens":{"denom":"` + denom + `","amount":"1000"}}`,
+			want: tokenfactorytypes.NewMsgMint(contract, sdk.NewInt64Coin(denom, 1000), ""),
+		},
+		{
+			name: "set metadata",
+			msg:  `{"set_metadata":{"metadata":{"description":"","denom_units":[{"denom":"` + denom + `","exponent":0,"aliases":[]}],"base":"` + denom + `","display":"` + denom + `","name":"token","symbol":"TKN"}}}`,
+			want: tokenfactorytypes.NewMsgSetDenomMetadata(contract, banktypes.Metadata{
+				DenomUnits: []*banktypes.DenomUnit{{Denom: denom, Aliases: []string{}}},
+				Base:       denom,
+				Display:    denom,
+				Name:       "token",
+				Symbol:     "TKN",
+			}),
+		},
+		{name: "invalid amount", msg: `{"burn_tokens":{"denom":"` + denom + `","amount":"1.5"}}`, wantErr: wasmtypes.ErrInvalidMsg},
+		{name: "unknown variant", msg: `{"unknown":{}}`, wantErr: wasmtypes.ErrUnknownMsg},
+		{name: "invalid json", msg: `[]`, wantErr: wasmtypes.ErrInvalidMsg},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msgs, err := EncodeOraiMsg(contract, []byte(tc.msg))
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []sdk.Msg{tc.want}, msgs)
+			require.NoError(t, msgs[0].ValidateBasic())
+		})
+	}
+}
+
+// mockRouter answers the msgs with a fixed response.
+type mockRouter struct {
+	msgs []sdk.Msg
+	res  *sdk.Result
+}
+
+func (r *mockRouter) Handler(msg sdk.Msg) baseapp.MsgServiceHandler {
+	if _, ok := msg.(*icqtypes.MsgSendQuery); !ok {
+		return nil
+	}
+	return func(_ sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		r.msgs = append(r.msgs, msg)
+		return r.res, nil
+	}
+}
+
+// mockMessenger records the msgs passed to the wrapped messenger.
+type mockMessenger struct {
+	msgs []wasmvmtypes.CosmosMsg
+}
+
+func (m *mockMessenger) DispatchMsg(_ sdk.Context, _ sdk.AccAddress, _ string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, error) {
+	m.msgs = append(m.msgs, msg)
+	return nil, nil, nil
+}
+
+func TestCustomMessenger(t *testing.T) {
+	contract := sdk.AccAddress("contract____________")
+	respData, err := (&icqtypes.MsgSendQueryResponse{Sequence: 7}).Marshal()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		msg         wasmvmtypes.CosmosMsg
+		wantMsg     sdk.Msg
+		wantData    string
+		wantWrapped bool
+		wantErr     error
+	}{
+		{
+			name:     "custom msg",
+			msg:      wasmvmtypes.CosmosMsg{Custom: []byte(`{"send_interchain_query":{"channel_id":"channel-0","requests":[{"path":"/store/bank/key","data":"AQI="}],"timeout_seconds":60}}`)},
+			wantMsg:  icqtypes.NewMsgSendQuery(contract, "channel-0", []icqtypes.QueryRequest{{Path: "/store/bank/key", Data: []byte{1, 2}}}, time.Minute),
+			wantData: `{"sequence":7}`,
+		},
+		{
+			name:        "other msg",
+			msg:         wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{Burn: &wasmvmtypes.BurnMsg{}}},
+			wantWrapped: true,
+		},
+		{
+			name:    "invalid custom msg",
+			msg:     wasmvmtypes.CosmosMsg{Custom: []byte(`{"send_interchain_query":{"channel_id":"channel-0","requests":[],"timeout_seconds":60}}`)},
+			wantErr: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name:    "custom msg without handler",
+			msg:     wasmvmtypes.CosmosMsg{Custom: []byte(`{"register_interchain_account":{"connection_id":"connection-0"}}`)},
+			wantErr: sdkerrors.ErrUnknownRequest,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			router := &mockRouter{res: &sdk.Result{Data: respData, Events: []abci.Event{{Type: "sent"}}}}
+			wrapped := &mockMessenger{}
+			messenger := CustomMessageDecorator(router)(wrapped)
+
+			events, data, err := messenger.DispatchMsg(sdk.Context{}, contract, "", tc.msg)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			if tc.wantWrapped {
+				require.Equal(t, []wasmvmtypes.CosmosMsg{tc.msg}, wrapped.msgs)
+				require.Empty(t, router.msgs)
+				return
+			}
+			require.Empty(t, wrapped.msgs)
+			require.Equal(t, []sdk.Msg{tc.wantMsg}, router.msgs)
+			require.Equal(t, []sdk.Event{{Type: "sent"}}, events)
+			require.Len(t, data, 1)
+			require.JSONEq(t, tc.wantData, string(data[0]))
+		})
+	}
+}
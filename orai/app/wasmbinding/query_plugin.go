@@ -0,0 +1,215 @@
+package wasmbinding
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	ibckeeper "github.com/cosmos/ibc-go/v4/modules/core/keeper"
+	ibchookskeeper "github.com/osmosis-labs/osmosis/x/ibc-hooks/keeper"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	icaauthkeeper "github.com/oraichain/orai/x/icaauth/keeper"
+	tokenfactorykeeper "github.com/oraichain/orai/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/oraichain/orai/x/tokenfactory/types"
+)
+
+// QueryPlugin holds the keepers answering the custom queries.
+type QueryPlugin struct {
+	bankKeeper         bankkeeper.Keeper
+	stakingKeeper      stakingkeeper.Keeper
+	distrKeeper        distrkeeper.Keeper
+	ibcKeeper          *ibckeeper.Keeper
+	icaAuthKeeper      *icaauthkeeper.Keeper
+	tokenFactoryKeeper *tokenfactorykeeper.Keeper
+}
+
+// NewQueryPlugin returns the query plugin. The pointed keepers are
+// dereferenced on each query, they may be set after the wasm keeper.
+func NewQueryPlugin(
+	bankKeeper bankkeeper.Keeper,
+	stakingKeeper stakingkeeper.Keeper,
+	distrKeeper distrkeeper.Keeper,
+	ibcKeeper *ibckeeper.Keeper,
+	icaAuthKeeper *icaauthkeeper.Keeper,
+	tokenFactoryKeeper *tokenfactorykeeper.Keeper,
+) *QueryPlugin {
+	return &QueryPlugin{
+		bankKeeper:         bankKeeper,
+		stakingKeeper:      stakingKeeper,
+		distrKeeper:        distrKeeper,
+		ibcKeeper:          ibcKeeper,
+		icaAuthKeeper:      icaAuthKeeper,
+		tokenFactoryKeeper: tokenFactoryKeeper,
+	}
+}
+
+// CustomQuerier answers the custom queries of contracts.
+func CustomQuerier(qp *QueryPlugin) wasmkeeper.CustomQuerier {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var query OraiQuery
+		if err := json.Unmarshal(request, &query); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+
+		var (
+			res interface{}
+			err error
+		)
+		switch {
+		case query.InterchainAccountAddress != nil:
+			res, err = qp.interchainAccountAddress(ctx, query.InterchainAccountAddress)
+		case query.DenomMetadata != nil:
+			res, err = qp.denomMetadata(ctx, query.DenomMetadata)
+		case query.StakingPool != nil:
+			res, err = qp.stakingPool(ctx)
+		case query.DelegationRewards != nil:
+			res, err = qp.delegationRewards(ctx, query.DelegationRewards)
+		case query.DelegatorWithdrawAddress != nil:
+			res, err = qp.delegatorWithdrawAddress(ctx, query.DelegatorWithdrawAddress)
+		case query.IBCChannel != nil:
+			res, err = qp.ibcChannel(ctx, query.IBCChannel)
+		case query.IBCHooksSender != nil:
+			res, err = ibcHooksSender(query.IBCHooksSender)
+		case query.FullDenom != nil:
+			res, err = fullDenom(query.FullDenom)
+		case query.DenomAdmin != nil:
+			res, err = qp.denomAdmin(ctx, query.DenomAdmin)
+		case query.DenomsByCreator != nil:
+			res, err = qp.denomsByCreator(ctx, query.DenomsByCreator)
+		case query.BeforeSendHook != nil:
+			res, err = qp.beforeSendHook(ctx, query.BeforeSendHook)
+		default:
+			return nil, sdkerrors.Wrap(wasmtypes.ErrInvalidMsg, "unknown variant of orai query")
+		}
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(res)
+	}
+}
+
+func (qp *QueryPlugin) interchainAccountAddress(ctx sdk.Context, req *InterchainAccountAddress) (*InterchainAccountAddressResponse, error) {
+	address, err := qp.icaAuthKeeper.GetInterchainAccountAddress(ctx, req.Owner, req.ConnectionID)
+	if err != nil {
+		return nil, err
+	}
+	return &InterchainAccountAddressResponse{InterchainAccountAddress: address}, nil
+}
+
+func (qp *QueryPlugin) denomMetadata(ctx sdk.Context, req *DenomMetadata) (*DenomMetadataResponse, error) {
+	metadata, found := qp.bankKeeper.GetDenomMetaData(ctx, req.Denom)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "metadata of denom %s", req.Denom)
+	}
+
+	units := make([]DenomUnit, len(metadata.DenomUnits))
+	for i, unit := range metadata.DenomUnits {
+		units[i] = DenomUnit{Denom: unit.Denom, Exponent: unit.Exponent, Aliases: append([]string{}, unit.Aliases...)}
+	}
+	return &DenomMetadataResponse{
+		Metadata: Metadata{
+			Description: metadata.Description,
+			DenomUnits:  units,
+			Base:        metadata.Base,
+			Display:     metadata.Display,
+			Name:        metadata.Name,
+			Symbol:      metadata.Symbol,
+		},
+	}, nil
+}
+
+func (qp *QueryPlugin) stakingPool(ctx sdk.Context) (*StakingPoolResponse, error) {
+	res, err := stakingkeeper.Querier{Keeper: qp.stakingKeeper}.Pool(sdk.WrapSDKContext(ctx), &stakingtypes.QueryPoolRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return &StakingPoolResponse{
+		BondedTokens:    res.Pool.BondedTokens.String(),
+		NotBondedTokens: res.Pool.NotBondedTokens.String(),
+	}, nil
+}
+
+func (qp *QueryPlugin) delegationRewards(ctx sdk.Context, req *DelegationRewards) (*DelegationRewardsResponse, error) {
+	res, err := qp.distrKeeper.DelegationRewards(sdk.WrapSDKContext(ctx), &distrtypes.QueryDelegationRewardsRequest{
+		DelegatorAddress: req.Delegator,
+		ValidatorAddress: req.Validator,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rewards := make([]DecCoin, len(res.Rewards))
+	for i, coin := range res.Rewards {
+		rewards[i] = DecCoin{Denom: coin.Denom, Amount: coin.Amount.String()}
+	}
+	return &DelegationRewardsResponse{Rewards: rewards}, nil
+}
+
+func (qp *QueryPlugin) delegatorWithdrawAddress(ctx sdk.Context, req *DelegatorWithdrawAddress) (*DelegatorWithdrawAddressResponse, error) {
+	res, err := qp.distrKeeper.DelegatorWithdrawAddress(sdk.WrapSDKContext(ctx), &distrtypes.QueryDelegatorWithdrawAddressRequest{
+		DelegatorAddress: req.Delegator,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &DelegatorWithdrawAddressResponse{WithdrawAddress: res.WithdrawAddress}, nil
+}
+
+func (qp *QueryPlugin) ibcChannel(ctx sdk.Context, req *IBCChannel) (*IBCChannelResponse, error) {
+	channel, found := qp.ibcKeeper.ChannelKeeper.GetChannel(ctx, req.PortID, req.ChannelID)
+	if !found {
+		return nil, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port %s, channel %s", req.PortID, req.ChannelID)
+	}
+
+	return &IBCChannelResponse{
+		Channel: Channel{
+			State:                 channel.State.String(),
+			Ordering:              channel.Ordering.String(),
+			CounterpartyPortID:    channel.Counterparty.PortId,
+			CounterpartyChannelID: channel.Counterparty.ChannelId,
+			ConnectionHops:        append([]string{}, channel.ConnectionHops...),
+			Version:               channel.Version,
+		},
+	}, nil
+}
+
+func ibcHooksSender(req *IBCHooksSender) (*IBCHooksSenderResponse, error) {
+	sender, err := ibchookskeeper.DeriveIntermediateSender(req.ChannelID, req.OriginalSender, sdk.GetConfig().GetBech32AccountAddrPrefix())
+	if err != nil {
+		return nil, err
+	}
+	return &IBCHooksSenderResponse{Sender: sender}, nil
+}
+
+func fullDenom(req *FullDenom) (*FullDenomResponse, error) {
+	denom, err := tokenfactorytypes.GetTokenDenom(req.CreatorAddr, req.Subdenom)
+	if err != nil {
+		return nil, err
+	}
+	return &FullDenomResponse{Denom: denom}, nil
+}
+
+func (qp *QueryPlugin) denomAdmin(ctx sdk.Context, req *DenomAdmin) (*DenomAdminResponse, error) {
+	metadata, found := qp.tokenFactoryKeeper.GetAuthorityMetadata(ctx, req.Denom)
+	if !found {
+		return nil, sdkerrors.Wrapf(tokenfactorytypes.ErrDenomDoesNotExist, "denom %s", req.Denom)
+	}
+	return &DenomAdminResponse{Admin: metadata.Admin}, nil
+}
+
+func (qp *QueryPlugin) denomsByCreator(ctx sdk.Context, req *DenomsByCreator) (*DenomsByCreatorResponse, error) {
+	return &DenomsByCreatorResponse{Denoms: qp.tokenFactoryKeeper.GetDenomsFromCreator(ctx, req.Creator)}, nil
+}
+
+func (qp *QueryPlugin) beforeSendHook(ctx sdk.Context, req *BeforeSendHook) (*BeforeSendHookResponse, error) {
+	return &BeforeSendHookResponse{Contract: qp.tokenFactoryKeeper.GetBeforeSendHook(ctx, req.Denom)}, nil
+}
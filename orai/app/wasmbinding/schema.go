@@ -0,0 +1,14 @@
+package wasmbinding
+
+import "embed"
+
+// SchemaVersion is the version of the JSON schema of the custom msgs and
+// queries, in schema/<version>. Contracts are built against a version: new
+// variants and optional fields may be added to it, any other change goes to a
+// new version.
+const SchemaVersion = "v1"
+
+// Schema holds the JSON schema of every version.
+//
+//go:embed schema
+var Schema embed.FS
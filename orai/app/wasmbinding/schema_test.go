@@ -0,0 +1,123 @@
+package wasmbinding
+
+import (
+	"encoding/json"
+	"path"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type jsonSchema struct {
+	Required   []string               `json:"required"`
+	Properties map[string]*jsonSchema `json:"properties"`
+	OneOf      []*jsonSchema          `json:"oneOf"`
+}
+
+func readSchema(t *testing.T, name string, v interface{}) {
+	t.Helper()
+	bz, err := Schema.ReadFile(path.Join("schema", SchemaVersion, name))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(bz, v))
+}
+
+// jsonFields returns the JSON names of the fields of a struct.
+func jsonFields(typ reflect.Type) []string {
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	var names []string
+	for i := 0; i < typ.NumField(); i++ {
+		names = append(names, strings.Split(typ.Field(i).Tag.Get("json"), ",")[0])
+	}
+	return names
+}
+
+// ensure that the schema describes the variants of the bindings and their
+// fields
+func TestSchema(t *testing.T) {
+	tests := []struct {
+		name      string
+		schema    string
+		responses string
+		typ       reflect.Type
+		// responseTypes maps the variants to their responses
+		responseTypes map[string]interface{}
+	}{
+		{
+			name:      "msg",
+			schema:    "orai_msg.json",
+			responses: "orai_msg_responses.json",
+			typ:       reflect.TypeOf(OraiMsg{}),
+			responseTypes: map[string]interface{}{
+				"register_interchain_account": RegisterInterchainAccountResponse{},
+				"submit_interchain_tx":        SubmitInterchainTxResponse{},
+				"send_interchain_query":       SendInterchainQueryResponse{},
+				"create_denom":                CreateDenomResponse{},
+				"mint_tokens":                 MintTokensResponse{},
+				"burn_tokens":                 BurnTokensResponse{},
+				"change_admin":                ChangeAdminResponse{},
+				"set_metadata":                SetMetadataResponse{},
+				"set_before_send_hook":        SetBeforeSendHookResponse{},
+			},
+		},
+		{
+			name:      "query",
+			schema:    "orai_query.json",
+			responses: "orai_query_responses.json",
+			typ:       reflect.TypeOf(OraiQuery{}),
+			responseTypes: map[string]interface{}{
+				"interchain_account_address": InterchainAccountAddressResponse{},
+				"denom_metadata":             DenomMetadataResponse{},
+				"staking_pool":               StakingPoolResponse{},
+				"delegation_rewards":         DelegationRewardsResponse{},
+				"delegator_withdraw_address": DelegatorWithdrawAddressResponse{},
+				"ibc_channel":                IBCChannelResponse{},
+				"ibc_hooks_sender":           IBCHooksSenderResponse{},
+				"full_denom":                 FullDenomResponse{},
+				"denom_admin":                DenomAdminResponse{},
+				"denoms_by_creator":          DenomsByCreatorResponse{},
+				"before_send_hook":           BeforeSendHookResponse{},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var schema jsonSchema
+			readSchema(t, tc.schema, &schema)
+			var responses map[string]*jsonSchema
+			readSchema(t, tc.responses, &responses)
+
+			variants := map[string]*jsonSchema{}
+			for _, variant := range schema.OneOf {
+				require.Len(t, variant.Required, 1)
+				variants[variant.Required[0]] = variant.Properties[variant.Required[0]]
+			}
+
+			require.Len(t, variants, tc.typ.NumField())
+			require.Len(t, responses, tc.typ.NumField())
+			require.Len(t, tc.responseTypes, tc.typ.NumField())
+			for i := 0; i < tc.typ.NumField(); i++ {
+				field := tc.typ.Field(i)
+				name := jsonFields(tc.typ)[i]
+
+				require.Contains(t, variants, name)
+				require.ElementsMatch(t, jsonFields(field.Type), keys(variants[name].Properties), name)
+
+				require.Contains(t, responses, name)
+				require.ElementsMatch(t, jsonFields(reflect.TypeOf(tc.responseTypes[name])), keys(responses[name].Properties), name)
+			}
+		})
+	}
+}
+
+func keys(m map[string]*jsonSchema) []string {
+	names := []string{}
+	for name := range m {
+		names = append(names, name)
+	}
+	return names
+}
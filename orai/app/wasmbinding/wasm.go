@@ -0,0 +1,19 @@
+package wasmbinding
+
+import (
+	"github.com/CosmWasm/wasmd/x/wasm"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+)
+
+// RegisterCustomPlugins returns the wasm options registering the custom msgs
+// and queries. Contracts depend on them when executing txs, so they are the
+// same on every node. They go after any WithMessageEncoders option, which
+// panics on the decorated messenger.
+func RegisterCustomPlugins(router wasmkeeper.MessageRouter, queryPlugin *QueryPlugin) []wasm.Option {
+	return []wasm.Option{
+		wasmkeeper.WithMessageHandlerDecorator(CustomMessageDecorator(router)),
+		wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
+			Custom: CustomQuerier(queryPlugin),
+		}),
+	}
+}
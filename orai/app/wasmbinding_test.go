@@ -0,0 +1,151 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/CosmWasm/wasmd/x/wasm"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibchookskeeper "github.com/osmosis-labs/osmosis/x/ibc-hooks/keeper"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	db "github.com/tendermint/tm-db"
+
+	"github.com/oraichain/orai/app/wasmbinding"
+)
+
+func setupWasmBindingApp(t *testing.T) (*OraichainApp, sdk.Context) {
+	gapp := NewOraichainApp(log.NewNopLogger(), db.NewMemDB(), nil, true, map[int64]bool{}, t.TempDir(), 0, MakeEncodingConfig(), wasm.EnableAllProposals, EmptyAppOptions{}, emptyWasmOpts)
+	stateBytes, err := json.Marshal(NewDefaultGenesisState(gapp.appCodec))
+	require.NoError(t, err)
+	gapp.InitChain(abci.RequestInitChain{AppStateBytes: stateBytes})
+	gapp.Commit()
+	return gapp, gapp.NewContext(true, tmproto.Header{Height: gapp.LastBlockHeight(), Time: time.Now()})
+}
+
+// ensure that the custom queries are answered by the keepers of the app
+func TestCustomQuerier(t *testing.T) {
+	gapp, ctx := setupWasmBindingApp(t)
+	querier := wasmbinding.CustomQuerier(wasmbinding.NewQueryPlugin(gapp.bankKeeper, gapp.stakingKeeper, gapp.distrKeeper, gapp.ibcKeeper, &gapp.ICAAuthKeeper, &gapp.TokenFactoryKeeper))
+
+	gapp.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Base:       "uatom",
+		Display:    "atom",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "uatom"}, {Denom: "atom", Exponent: 6}},
+	})
+	delegator := sdk.AccAddress("delegator___________")
+	hooksSender, err := ibchookskeeper.DeriveIntermediateSender("channel-0", "cosmos1sender", sdk.GetConfig().GetBech32AccountAddrPrefix())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		query   string
+		want    string
+		wantErr string
+	}{
+		{
+			name:  "denom metadata",
+			query: `{"denom_metadata":{"denom":"uatom"}}`,
+			want:  `{"metadata":{"description":"","denom_units":[{"denom":"uatom","exponent":0,"aliases":[]},{"denom":"atom","exponent":6,"aliases":[]}],"base":"uatom","display":"atom","name":"","symbol":""}}`,
+		},
+		{name: "unknown denom metadata", query: `{"denom_metadata":{"denom":"unknown"}}`, wantErr: "metadata of denom unknown"},
+		{name: "staking pool", query: `{"staking_pool":{}}`, want: `{"bonded_tokens":"0","not_bonded_tokens":"0"}`},
+		{
+			name:  "delegator withdraw address",
+			query: `{"delegator_withdraw_address":{"delegator":"` + delegator.String() + `"}}`,
+			want:  `{"withdraw_address":"` + delegator.String() + `"}`,
+		},
+		{
+			name:    "rewards of unknown validator",
+			query:   `{"delegation_rewards":{"delegator":"` + delegator.String() + `","validator":"` + sdk.ValAddress(delegator).String() + `"}}`,
+			wantErr: "validator does not exist",
+		},
+		{name: "unknown ibc channel", query: `{"ibc_channel":{"port_id":"transfer","channel_id":"channel-0"}}`, wantErr: "channel not found"},
+		{
+			name:  "ibc hooks sender",
+			query: `{"ibc_hooks_sender":{"channel_id":"channel-0","original_sender":"cosmos1sender"}}`,
+			want:  `{"sender":"` + hooksSender + `"}`,
+		},
+		{name: "unknown variant", query: `{"unknown":{}}`, wantErr: "unknown variant of orai query"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := querier(ctx, []byte(tc.query))
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.JSONEq(t, tc.want, string(res))
+		})
+	}
+}
+
+// reflectExecuteMsg is the execute msg of the reflect sample contract
+type reflectExecuteMsg struct {
+	ReflectMsg struct {
+		Msgs []wasmvmtypes.CosmosMsg `json:"msgs"`
+	} `json:"reflect_msg"`
+}
+
+// ensure that the custom msgs and queries of contracts reach the plugins,
+// using the reflect sample contract which sends any msg and custom query
+func TestCustomPluginsWiring(t *testing.T) {
+	gapp, ctx := setupWasmBindingApp(t)
+
+	creator := sdk.AccAddress("creator_____________")
+	code, err := os.ReadFile("./bytecode/reflect.wasm")
+	require.NoError(t, err)
+	codeID, _, err := gapp.ContractKeeper.Create(ctx, creator, code, nil)
+	require.NoError(t, err)
+	contract, _, err := gapp.ContractKeeper.Instantiate(ctx, codeID, creator, creator, []byte(`{}`), "reflect", nil)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		msg     wasmvmtypes.CosmosMsg
+		wantErr string
+	}{
+		{
+			name: "msg passed to the wrapped messenger",
+			msg:  wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{Send: &wasmvmtypes.SendMsg{ToAddress: creator.String(), Amount: wasmvmtypes.Coins{}}}},
+		},
+		{
+			// the custom msgs of the reflect contract are not orai msgs
+			name:    "custom msg",
+			msg:     wasmvmtypes.CosmosMsg{Custom: []byte(`{"debug":"hello"}`)},
+			wantErr: "unknown variant of orai msg",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var execMsg reflectExecuteMsg
+			execMsg.ReflectMsg.Msgs = []wasmvmtypes.CosmosMsg{tc.msg}
+			bz, err := json.Marshal(execMsg)
+			require.NoError(t, err)
+
+			cacheCtx, _ := ctx.CacheContext()
+			_, err = gapp.ContractKeeper.Execute(cacheCtx, contract, creator, bz, nil)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	// the custom queries of the reflect contract are not orai queries, the
+	// error returned to the contract is redacted to its code
+	_, err = gapp.wasmKeeper.QuerySmart(ctx, contract, []byte(`{"capitalized":{"text":"orai"}}`))
+	require.ErrorContains(t, err, fmt.Sprintf("codespace: %s, code: %d", wasmtypes.ErrInvalidMsg.Codespace(), wasmtypes.ErrInvalidMsg.ABCICode()))
+}
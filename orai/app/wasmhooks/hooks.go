@@ -0,0 +1,159 @@
+package wasmhooks
+
+import (
+	"encoding/json"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+	ibchooks "github.com/osmosis-labs/osmosis/x/ibc-hooks"
+	ibchookskeeper "github.com/osmosis-labs/osmosis/x/ibc-hooks/keeper"
+	ibchookstypes "github.com/osmosis-labs/osmosis/x/ibc-hooks/types"
+)
+
+const (
+	EventTypeAckCallbackError     = "ibc-ack-callback-error"
+	EventTypeTimeoutCallbackError = "ibc-timeout-callback-error"
+
+	AttributeKeyContract = "contract"
+	AttributeKeyMessage  = "message"
+	AttributeKeyError    = "error"
+)
+
+// WasmHooks are the ibc-hooks wasm hooks with stricter ibc_callback
+// handling. The contract named by the ibc_callback of an ICS-20 packet must
+// be its sender, and a failing callback no longer fails the acknowledgement
+// of the packet, so that the refund of an error ack is never blocked by the
+// contract.
+type WasmHooks struct {
+	ibchooks.WasmHooks
+
+	ibcHooksKeeper *ibchookskeeper.Keeper
+}
+
+func NewWasmHooks(ibcHooksKeeper *ibchookskeeper.Keeper, contractKeeper *wasmkeeper.PermissionedKeeper, bech32PrefixAccAddr string) WasmHooks {
+	return WasmHooks{
+		WasmHooks:      ibchooks.NewWasmHooks(ibcHooksKeeper, contractKeeper, bech32PrefixAccAddr),
+		ibcHooksKeeper: ibcHooksKeeper,
+	}
+}
+
+// SudoMsg is the msg sent to the ibc_callback contract of a packet once its
+// lifecycle completes.
+type SudoMsg struct {
+	IBCLifecycleComplete *IBCLifecycleComplete `json:"ibc_lifecycle_complete,omitempty"`
+}
+
+type IBCLifecycleComplete struct {
+	IBCAck     *IBCAck     `json:"ibc_ack,omitempty"`
+	IBCTimeout *IBCTimeout `json:"ibc_timeout,omitempty"`
+}
+
+type IBCAck struct {
+	Channel  string `json:"channel"`
+	Sequence uint64 `json:"sequence"`
+	Ack      []byte `json:"ack"`
+	Success  bool   `json:"success"`
+}
+
+type IBCTimeout struct {
+	Channel  string `json:"channel"`
+	Sequence uint64 `json:"sequence"`
+}
+
+// SendPacketOverride rejects ICS-20 packets whose ibc_callback is not their
+// sender, then lets the wasm hooks store the callback.
+func (h WasmHooks) SendPacketOverride(i ibchooks.ICS4Middleware, ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI) error {
+	var data transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err == nil && data.Memo != "" {
+		var memo map[string]interface{}
+		if err := json.Unmarshal([]byte(data.Memo), &memo); err == nil {
+			if contract, ok := memo[ibchookstypes.IBCCallbackKey].(string); ok && contract != data.Sender {
+				return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "ibc_callback contract %s is not the packet sender %s", contract, data.Sender)
+			}
+		}
+	}
+
+	return h.WasmHooks.SendPacketOverride(i, ctx, chanCap, packet)
+}
+
+func (h WasmHooks) OnAcknowledgementPacketOverride(im ibchooks.IBCMiddleware, ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
+	if err := im.App.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	// acks that are not standard ones are reported as successful
+	var ack channeltypes.Acknowledgement
+	isAckError := false
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err == nil {
+		_, isAckError = ack.Response.(*channeltypes.Acknowledgement_Error)
+	}
+
+	return h.callback(ctx, packet, EventTypeAckCallbackError, IBCLifecycleComplete{
+		IBCAck: &IBCAck{
+			Channel:  packet.SourceChannel,
+			Sequence: packet.Sequence,
+			Ack:      acknowledgement,
+			Success:  !isAckError,
+		},
+	})
+}
+
+func (h WasmHooks) OnTimeoutPacketOverride(im ibchooks.IBCMiddleware, ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	if err := im.App.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	return h.callback(ctx, packet, EventTypeTimeoutCallbackError, IBCLifecycleComplete{
+		IBCTimeout: &IBCTimeout{
+			Channel:  packet.SourceChannel,
+			Sequence: packet.Sequence,
+		},
+	})
+}
+
+// callback sudoes the ibc_callback contract of a packet, if any, and deletes
+// the callback. The packet will not complete again, so a failing callback is
+// only reported in an event and its state changes are discarded.
+func (h WasmHooks) callback(ctx sdk.Context, packet channeltypes.Packet, errorEventType string, lifecycle IBCLifecycleComplete) error {
+	if !h.ProperlyConfigured() {
+		return nil
+	}
+
+	contract := h.ibcHooksKeeper.GetPacketCallback(ctx, packet.SourceChannel, packet.Sequence)
+	if contract == "" {
+		return nil
+	}
+	h.ibcHooksKeeper.DeletePacketCallback(ctx, packet.SourceChannel, packet.Sequence)
+
+	contractAddr, err := sdk.AccAddressFromBech32(contract)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "ibc_callback contract %s", contract)
+	}
+
+	msg, err := json.Marshal(SudoMsg{IBCLifecycleComplete: &lifecycle})
+	if err != nil {
+		return err
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if _, err := h.ContractKeeper.Sudo(cacheCtx, contractAddr, msg); err != nil {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				errorEventType,
+				sdk.NewAttribute(AttributeKeyContract, contract),
+				sdk.NewAttribute(AttributeKeyMessage, string(msg)),
+				sdk.NewAttribute(AttributeKeyError, err.Error()),
+			),
+		)
+		return nil
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
@@ -105,7 +105,8 @@ func NewRootCmd() (*cobra.Command, params.EncodingConfig) {
 				return err
 			}
 
-			return server.InterceptConfigsPreRunHandler(cmd, "", nil)
+			customAppTemplate, customAppConfig := initAppConfig()
+			return server.InterceptConfigsPreRunHandler(cmd, customAppTemplate, customAppConfig)
 		},
 	}
 
@@ -114,6 +115,22 @@ func NewRootCmd() (*cobra.Command, params.EncodingConfig) {
 	return rootCmd, encodingConfig
 }
 
+// appConfig is the content of app.toml, the server config followed by the
+// [orai] section.
+type appConfig struct {
+	srvconfig.Config `mapstructure:",squash"`
+
+	Orai app.Config `mapstructure:"orai"`
+}
+
+// initAppConfig returns the template and default values of app.toml.
+func initAppConfig() (string, interface{}) {
+	return srvconfig.DefaultConfigTemplate + app.ConfigTemplate, appConfig{
+		Config: *srvconfig.DefaultConfig(),
+		Orai:   app.DefaultConfig(),
+	}
+}
+
 func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 	rootCmd.AddCommand(
 		initCmd(app.ModuleBasics, app.NewDefaultGenesisState(encodingConfig.Codec), app.DefaultNodeHome),
@@ -138,6 +155,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		queryCommand(),
 		txCommand(),
 		keys.Commands(app.DefaultNodeHome),
+		upgradeCmd(ac),
 	)
 }
 
@@ -323,7 +341,8 @@ func initCmd(_ module.BasicManager, customAppState app.GenesisState, defaultNode
 
 			serverCtx := server.GetServerContextFromCmd(cmd)
 			// config for app.toml file
-			appConfg := srvconfig.DefaultConfig()
+			_, customAppConfig := initAppConfig()
+			appConfg := customAppConfig.(appConfig)
 			appConfg.API.Enable = true
 			// config for config.toml file
 			config := serverCtx.Config
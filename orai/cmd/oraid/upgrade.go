@@ -0,0 +1,134 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm"
+	"github.com/oraichain/orai/app"
+)
+
+// upgradeCmd returns the commands checking software upgrades on a node home.
+func upgradeCmd(ac appCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Software upgrade utilities",
+	}
+
+	cmd.AddCommand(ac.upgradeDryRunCmd())
+
+	return cmd
+}
+
+func (ac appCreator) upgradeDryRunCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dry-run [upgrade-name]",
+		Short: "Apply a registered upgrade on the latest height without committing it",
+		Long: `Load the app at the latest height of the node home, apply the store upgrades
+and run the handler of the named upgrade in a cached context, then run all crisis
+invariants. Prints the module versions and store sizes before and after the upgrade.
+
+Nothing is committed, but loading the stores may rewrite the database format:
+run it on a copy of the node home, with the node stopped.`,
+		Example: fmt.Sprintf("%s upgrade dry-run v0.42.0 --home /tmp/orai-copy", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			genDoc, err := tmtypes.GenesisDocFromFile(config.GenesisFile())
+			if err != nil {
+				return err
+			}
+
+			db, err := sdk.NewLevelDB("application", config.DBDir())
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			var emptyWasmOpts []wasm.Option
+			oraiApp := app.NewOraichainApp(
+				serverCtx.Logger,
+				db,
+				nil,
+				false,
+				map[int64]bool{},
+				homeDir,
+				cast.ToUint(serverCtx.Viper.Get(server.FlagInvCheckPeriod)),
+				ac.encCfg,
+				app.GetEnabledProposals(),
+				serverCtx.Viper,
+				emptyWasmOpts,
+			)
+
+			report, err := oraiApp.DryRunUpgrade(db, args[0], genDoc.ChainID)
+			if err != nil {
+				return err
+			}
+
+			if output, _ := cmd.Flags().GetString(tmcli.OutputFlag); output == "json" {
+				bz, err := json.MarshalIndent(report, "", "  ")
+				if err != nil {
+					return err
+				}
+				fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+			} else {
+				printUpgradeDryRun(cmd, report)
+			}
+
+			if len(report.BrokenInvariants) > 0 {
+				return fmt.Errorf("upgrade %s breaks %d invariants", report.UpgradeName, len(report.BrokenInvariants))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, app.DefaultNodeHome, "The application home directory")
+	cmd.Flags().StringP(tmcli.OutputFlag, "o", "text", "Output format (text|json)")
+
+	return cmd
+}
+
+func printUpgradeDryRun(cmd *cobra.Command, report app.UpgradeDryRun) {
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(w, "Upgrade %s at height %d\n\n", report.UpgradeName, report.Height)
+
+	fmt.Fprintln(w, "MODULE\tFROM\tTO\t")
+	for _, change := range report.Versions {
+		if change.Changed() {
+			fmt.Fprintf(w, "%s\t%d\t%d\t\n", change.Module, change.From, change.To)
+		}
+	}
+
+	fmt.Fprintln(w, "\nSTORE\tKEYS BEFORE\tKEYS AFTER\tBYTES BEFORE\tBYTES AFTER\t")
+	for _, store := range report.Stores {
+		if store.Before != store.After {
+			fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t\n", store.Store, store.Before.Keys, store.After.Keys, store.Before.Bytes, store.After.Bytes)
+		}
+	}
+
+	fmt.Fprintln(w, "\nBROKEN INVARIANTS")
+	for _, msg := range report.BrokenInvariants {
+		fmt.Fprint(w, msg)
+	}
+	if len(report.BrokenInvariants) == 0 {
+		fmt.Fprintln(w, "none")
+	}
+
+	w.Flush()
+}
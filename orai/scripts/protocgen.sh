@@ -0,0 +1,44 @@
+#!/usr/bin/env bash
+
+set -eo pipefail
+
+# go install github.com/regen-network/cosmos-proto/protoc-gen-gocosmos@v0.3.1
+# go install github.com/grpc-ecosystem/grpc-gateway/protoc-gen-grpc-gateway@v1.16.0
+# go install github.com/bufbuild/buf/cmd/buf@v1.28.1
+
+BASEDIR=$(dirname $0)
+PROJECTDIR=$(realpath $BASEDIR/..)
+
+COSMOS_SDK_DIR=${COSMOS_SDK_DIR:-$(go list -f "{{ .Dir }}" -m github.com/cosmos/cosmos-sdk)}
+IBC_DIR=${IBC_DIR:-$(go list -f "{{ .Dir }}" -m github.com/cosmos/ibc-go/v4)}
+
+cd $PROJECTDIR
+
+# only generate the oraichain modules, the other proto files are kept for reference
+proto_dirs=$(find ./proto/orai -path -prune -o -name '*.proto' -print0 | xargs -0 -n1 dirname | sort | uniq)
+for dir in $proto_dirs; do
+  buf alpha protoc \
+  -I "proto" \
+  -I="$IBC_DIR/proto" \
+  -I="$COSMOS_SDK_DIR/third_party/proto" \
+  -I="$COSMOS_SDK_DIR/proto" \
+  --gocosmos_out=plugins=interfacetype+grpc,\
+Mgoogle/protobuf/any.proto=github.com/cosmos/cosmos-sdk/codec/types:. \
+  $(find "${dir}" -maxdepth 1 -name '*.proto')
+
+  # command to generate gRPC gateway (*.pb.gw.go in respective modules) files
+  query_file=$(find "${dir}" -maxdepth 1 -name 'query.proto')
+  if [[ ! -z "$query_file" ]]; then
+    buf alpha protoc \
+    -I "proto" \
+    -I="$IBC_DIR/proto" \
+    -I="$COSMOS_SDK_DIR/third_party/proto" \
+    -I="$COSMOS_SDK_DIR/proto" \
+    --grpc-gateway_out=logtostderr=true,allow_colon_final_segments=true:. \
+    $query_file
+  fi
+done
+
+# move generated files to the right places
+cp -r github.com/oraichain/orai/* ./
+rm -rf github.com
@@ -0,0 +1,18 @@
+package clock
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/oraichain/orai/x/clock/keeper"
+	"github.com/oraichain/orai/x/clock/types"
+)
+
+// EndBlocker calls the registered contracts at the end of the block.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.CallClockContracts(ctx)
+}
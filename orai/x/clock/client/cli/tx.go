@@ -0,0 +1,123 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/oraichain/orai/x/clock/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Contracts called every block transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(
+		GetCmdRegisterClockContract(),
+		GetCmdUnregisterClockContract(),
+		GetCmdUnjailClockContract(),
+	)
+	return txCmd
+}
+
+func GetCmdRegisterClockContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "register [contract] [gas-limit]",
+		Short:   "Call the contract at the end of every block, sent by the contract admin or creator",
+		Long:    "Call the contract at the end of every block, sent by the contract admin or creator. The gas limit of each call defaults to the max gas limit.",
+		Example: "oraid tx clock register orai1... 500000 --from admin",
+		Args:    cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			var gasLimit uint64
+			if len(args) > 1 {
+				if gasLimit, err = strconv.ParseUint(args[1], 10, 64); err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgRegisterClockContract(clientCtx.GetFromAddress(), contract, gasLimit)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdUnregisterClockContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unregister [contract]",
+		Short: "Stop calling the contract every block, sent by the contract admin or creator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnregisterClockContract(clientCtx.GetFromAddress(), contract)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdUnjailClockContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unjail [contract]",
+		Short: "Call the jailed contract again, sent by the contract admin or creator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnjailClockContract(clientCtx.GetFromAddress(), contract)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
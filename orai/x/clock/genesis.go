@@ -0,0 +1,30 @@
+package clock
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/oraichain/orai/x/clock/keeper"
+	"github.com/oraichain/orai/x/clock/types"
+)
+
+// InitGenesis import module genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	data types.GenesisState,
+) {
+	if err := data.Validate(); err != nil {
+		panic(err)
+	}
+
+	k.SetParams(ctx, data.Params)
+
+	for _, c := range data.ClockContracts {
+		k.SetClockContract(ctx, c)
+	}
+}
+
+// ExportGenesis export module state
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx), k.GetAllClockContracts(ctx))
+}
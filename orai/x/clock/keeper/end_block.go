@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/oraichain/orai/x/clock/types"
+)
+
+// CallClockContracts calls the contracts that are not jailed with the
+// clock_end_block sudo msg, each within its own gas limit. The state changes
+// of a failed call are discarded and its error counted, the contract is
+// jailed once it fails max errors calls in a row.
+func (k Keeper) CallClockContracts(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	msg := []byte(types.EndBlockSudoMessage)
+
+	// the registrations are updated while calling the contracts, so they are
+	// not iterated over directly
+	for _, c := range k.GetAllClockContracts(ctx) {
+		if c.IsJailed {
+			continue
+		}
+
+		// lowering the max gas limit applies to the registered contracts
+		gasLimit := c.GasLimit
+		if gasLimit > params.ContractGasLimit {
+			gasLimit = params.ContractGasLimit
+		}
+
+		err := k.sudo(ctx, sdk.MustAccAddressFromBech32(c.ContractAddress), msg, gasLimit)
+		if err == nil {
+			if c.ErrorCount > 0 {
+				c.ErrorCount = 0
+				k.SetClockContract(ctx, c)
+			}
+			continue
+		}
+
+		c.ErrorCount++
+		c.IsJailed = c.ErrorCount >= params.MaxErrors
+		k.SetClockContract(ctx, c)
+
+		k.Logger(ctx).Debug("clock contract failed", "contract", c.ContractAddress, "error_count", c.ErrorCount, "error", err)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeClockContractError,
+				sdk.NewAttribute(types.AttributeKeyContract, c.ContractAddress),
+				sdk.NewAttribute(types.AttributeKeyErrorCount, strconv.FormatUint(c.ErrorCount, 10)),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			),
+		)
+		if c.IsJailed {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeJailClockContract,
+					sdk.NewAttribute(types.AttributeKeyContract, c.ContractAddress),
+				),
+			)
+		}
+	}
+}
+
+func (k Keeper) sudo(ctx sdk.Context, contract sdk.AccAddress, msg []byte, gasLimit uint64) (err error) {
+	gasMeter := sdk.NewGasMeter(gasLimit)
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter)
+
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "clock contract exceeds gas limit %d", gasLimit)
+		}
+		ctx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), "clock contract")
+	}()
+
+	if _, err := k.contractKeeper.Sudo(cacheCtx, contract, msg); err != nil {
+		return err
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/oraichain/orai/x/clock/types"
+)
+
+// Keeper of the clock store
+type Keeper struct {
+	storeKey   sdk.StoreKey
+	cdc        codec.BinaryCodec
+	paramSpace paramtypes.Subspace
+
+	contractKeeper types.ContractKeeper
+}
+
+func NewKeeper(
+	storeKey sdk.StoreKey,
+	cdc codec.BinaryCodec,
+	paramSpace paramtypes.Subspace,
+	contractKeeper types.ContractKeeper,
+) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:       storeKey,
+		cdc:            cdc,
+		paramSpace:     paramSpace,
+		contractKeeper: contractKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns the current x/clock module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (p types.Params) {
+	k.paramSpace.GetParamSet(ctx, &p)
+	return p
+}
+
+// SetParams sets the x/clock module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, p types.Params) {
+	k.paramSpace.SetParamSet(ctx, &p)
+}
+
+// SetClockContract stores the registration of a contract.
+func (k Keeper) SetClockContract(ctx sdk.Context, c types.ClockContract) {
+	contract := sdk.MustAccAddressFromBech32(c.ContractAddress)
+	ctx.KVStore(k.storeKey).Set(types.GetClockContractKey(contract), k.cdc.MustMarshal(&c))
+}
+
+// GetClockContract returns the registration of a contract.
+func (k Keeper) GetClockContract(ctx sdk.Context, contract sdk.AccAddress) (c types.ClockContract, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetClockContractKey(contract))
+	if bz == nil {
+		return c, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &c)
+	return c, true
+}
+
+// DeleteClockContract removes the registration of a contract.
+func (k Keeper) DeleteClockContract(ctx sdk.Context, contract sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.GetClockContractKey(contract))
+}
+
+// IterateClockContracts iterates over all registrations until cb returns
+// true.
+func (k Keeper) IterateClockContracts(ctx sdk.Context, cb func(c types.ClockContract) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClockContractPrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var c types.ClockContract
+		k.cdc.MustUnmarshal(iter.Value(), &c)
+		if cb(c) {
+			break
+		}
+	}
+}
+
+// GetAllClockContracts returns all registrations.
+func (k Keeper) GetAllClockContracts(ctx sdk.Context) []types.ClockContract {
+	contracts := []types.ClockContract{}
+	k.IterateClockContracts(ctx, func(c types.ClockContract) bool {
+		contracts = append(contracts, c)
+		return false
+	})
+	return contracts
+}
+
+// IsAuthorized returns nil if the sender manages the clock registration of
+// the contract, which is the contract itself, its admin or, when it has none,
+// its creator.
+func (k Keeper) IsAuthorized(ctx sdk.Context, sender, contract sdk.AccAddress) error {
+	info := k.contractKeeper.GetContractInfo(ctx, contract)
+	if info == nil {
+		return sdkerrors.Wrap(types.ErrContractNotFound, contract.String())
+	}
+
+	switch {
+	case sender.Equals(contract):
+		return nil
+	case info.Admin != "":
+		if info.Admin == sender.String() {
+			return nil
+		}
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is neither the contract nor its admin", sender)
+	case info.Creator == sender.String():
+		return nil
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is neither the contract nor its creator", sender)
+	}
+}
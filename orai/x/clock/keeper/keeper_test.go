@@ -0,0 +1,228 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/oraichain/orai/x/clock/keeper"
+	"github.com/oraichain/orai/x/clock/types"
+)
+
+var (
+	admin    = sdk.AccAddress("admin_______________")
+	creator  = sdk.AccAddress("creator_____________")
+	stranger = sdk.AccAddress("stranger____________")
+)
+
+type mockContractKeeper struct {
+	infos map[string]*wasmtypes.ContractInfo
+	// errs are returned by the sudo calls of the contracts
+	errs  map[string]error
+	calls map[string]int
+}
+
+func newMockContractKeeper() *mockContractKeeper {
+	return &mockContractKeeper{
+		infos: map[string]*wasmtypes.ContractInfo{},
+		errs:  map[string]error{},
+		calls: map[string]int{},
+	}
+}
+
+func (k *mockContractKeeper) GetContractInfo(_ sdk.Context, contract sdk.AccAddress) *wasmtypes.ContractInfo {
+	return k.infos[contract.String()]
+}
+
+func (k *mockContractKeeper) Sudo(_ sdk.Context, contract sdk.AccAddress, _ []byte) ([]byte, error) {
+	k.calls[contract.String()]++
+	return nil, k.errs[contract.String()]
+}
+
+func setupKeeper(t *testing.T, contractKeeper types.ContractKeeper) (sdk.Context, keeper.Keeper, sdk.StoreKey) {
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+
+	ms := store.NewCommitMultiStore(dbm.NewMemDB())
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	encCfg := simapp.MakeTestEncodingConfig()
+	paramsKeeper := paramskeeper.NewKeeper(encCfg.Marshaler, codec.NewLegacyAmino(), paramsKey, paramsTKey)
+	k := keeper.NewKeeper(key, encCfg.Marshaler, paramsKeeper.Subspace(types.ModuleName), contractKeeper)
+
+	return sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger()), k, key
+}
+
+func TestCallClockContracts(t *testing.T) {
+	contractKeeper := newMockContractKeeper()
+	ctx, k, _ := setupKeeper(t, contractKeeper)
+	k.SetParams(ctx, types.DefaultParams())
+
+	failing := sdk.AccAddress("failing_contract____")
+	working := sdk.AccAddress("working_contract____")
+	contractKeeper.errs[failing.String()] = errors.New("failed")
+	k.SetClockContract(ctx, types.NewClockContract(failing, types.MinContractGasLimit))
+	k.SetClockContract(ctx, types.NewClockContract(working, types.MinContractGasLimit))
+
+	// the contract is jailed once it fails max errors calls in a row
+	for i := uint64(1); i <= types.DefaultParams().MaxErrors; i++ {
+		k.CallClockContracts(ctx)
+		c, found := k.GetClockContract(ctx, failing)
+		require.True(t, found)
+		require.Equal(t, i, c.ErrorCount)
+		require.Equal(t, i == types.DefaultParams().MaxErrors, c.IsJailed)
+	}
+
+	// jailed contracts are no longer called
+	k.CallClockContracts(ctx)
+	require.Equal(t, 3, contractKeeper.calls[failing.String()])
+	require.Equal(t, 4, contractKeeper.calls[working.String()])
+	c, _ := k.GetClockContract(ctx, working)
+	require.Zero(t, c.ErrorCount)
+
+	// a successful call resets the error count
+	flaky := sdk.AccAddress("flaky_contract______")
+	contractKeeper.errs[flaky.String()] = errors.New("failed")
+	k.SetClockContract(ctx, types.NewClockContract(flaky, types.MinContractGasLimit))
+	k.CallClockContracts(ctx)
+	k.CallClockContracts(ctx)
+	delete(contractKeeper.errs, flaky.String())
+	k.CallClockContracts(ctx)
+	c, _ = k.GetClockContract(ctx, flaky)
+	require.Zero(t, c.ErrorCount)
+	require.False(t, c.IsJailed)
+}
+
+func TestMsgServerAuthorization(t *testing.T) {
+	contractKeeper := newMockContractKeeper()
+	ctx, k, _ := setupKeeper(t, contractKeeper)
+	k.SetParams(ctx, types.DefaultParams())
+	msgServer := keeper.NewMsgServerImpl(k)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	withAdmin := sdk.AccAddress("admin_contract______")
+	withoutAdmin := sdk.AccAddress("creator_contract____")
+	contractKeeper.infos[withAdmin.String()] = &wasmtypes.ContractInfo{Creator: creator.String(), Admin: admin.String()}
+	contractKeeper.infos[withoutAdmin.String()] = &wasmtypes.ContractInfo{Creator: creator.String()}
+
+	jail := func(contract sdk.AccAddress) {
+		c := types.NewClockContract(contract, types.MinContractGasLimit)
+		c.IsJailed, c.ErrorCount = true, 3
+		k.SetClockContract(ctx, c)
+	}
+	jail(withAdmin)
+	jail(withoutAdmin)
+
+	cases := map[string]struct {
+		sender   sdk.AccAddress
+		contract sdk.AccAddress
+		expErr   error
+	}{
+		"admin":                        {sender: admin, contract: withAdmin},
+		"contract itself":              {sender: withAdmin, contract: withAdmin},
+		"creator of admin contract":    {sender: creator, contract: withAdmin, expErr: sdkerrors.ErrUnauthorized},
+		"stranger":                     {sender: stranger, contract: withAdmin, expErr: sdkerrors.ErrUnauthorized},
+		"creator of contract":          {sender: creator, contract: withoutAdmin},
+		"stranger of creator contract": {sender: stranger, contract: withoutAdmin, expErr: sdkerrors.ErrUnauthorized},
+		"unknown contract":             {sender: stranger, contract: stranger, expErr: types.ErrContractNotFound},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cacheCtx, _ := ctx.CacheContext()
+			goCtx := sdk.WrapSDKContext(cacheCtx)
+
+			_, err := msgServer.UnjailClockContract(goCtx, &types.MsgUnjailClockContract{SenderAddress: tc.sender.String(), ContractAddress: tc.contract.String()})
+			require.ErrorIs(t, err, tc.expErr)
+			_, err = msgServer.UnregisterClockContract(goCtx, &types.MsgUnregisterClockContract{SenderAddress: tc.sender.String(), ContractAddress: tc.contract.String()})
+			require.ErrorIs(t, err, tc.expErr)
+		})
+	}
+
+	// unjailing resets the error count, and only applies to jailed contracts
+	_, err := msgServer.UnjailClockContract(goCtx, &types.MsgUnjailClockContract{SenderAddress: admin.String(), ContractAddress: withAdmin.String()})
+	require.NoError(t, err)
+	c, _ := k.GetClockContract(ctx, withAdmin)
+	require.False(t, c.IsJailed)
+	require.Zero(t, c.ErrorCount)
+	_, err = msgServer.UnjailClockContract(goCtx, &types.MsgUnjailClockContract{SenderAddress: admin.String(), ContractAddress: withAdmin.String()})
+	require.ErrorIs(t, err, types.ErrContractNotJailed)
+
+	_, err = msgServer.UnregisterClockContract(goCtx, &types.MsgUnregisterClockContract{SenderAddress: creator.String(), ContractAddress: withoutAdmin.String()})
+	require.NoError(t, err)
+	_, found := k.GetClockContract(ctx, withoutAdmin)
+	require.False(t, found)
+	_, err = msgServer.UnregisterClockContract(goCtx, &types.MsgUnregisterClockContract{SenderAddress: creator.String(), ContractAddress: withoutAdmin.String()})
+	require.ErrorIs(t, err, types.ErrContractNotRegistered)
+}
+
+// encodeLegacyParams encodes the params of the Juno clock module
+func encodeLegacyParams(contracts []string, gasLimit uint64) []byte {
+	var bz []byte
+	for _, contract := range contracts {
+		bz = protowire.AppendTag(bz, 1, protowire.BytesType)
+		bz = protowire.AppendString(bz, contract)
+	}
+	bz = protowire.AppendTag(bz, 2, protowire.VarintType)
+	return protowire.AppendVarint(bz, gasLimit)
+}
+
+func TestMigrate1to2(t *testing.T) {
+	registered := sdk.AccAddress("registered_contract_")
+	listed := sdk.AccAddress("listed_contract_____")
+
+	cases := map[string]struct {
+		gasLimit    uint64
+		expGasLimit uint64
+	}{
+		"legacy gas limit":    {gasLimit: 500_000, expGasLimit: 500_000},
+		"gas limit below min": {gasLimit: types.MinContractGasLimit - 1, expGasLimit: types.DefaultParams().ContractGasLimit},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, k, key := setupKeeper(t, newMockContractKeeper())
+			k.SetClockContract(ctx, types.NewClockContract(registered, 200_000))
+			store := ctx.KVStore(key)
+			store.Set(types.LegacyParamsKey, encodeLegacyParams([]string{registered.String(), "invalid", listed.String()}, tc.gasLimit))
+
+			require.NoError(t, keeper.NewMigrator(k).Migrate1to2(ctx))
+			require.False(t, store.Has(types.LegacyParamsKey))
+			require.Equal(t, types.NewParams(tc.expGasLimit, types.DefaultParams().MaxErrors), k.GetParams(ctx))
+
+			// the registered contracts are kept as they are, the invalid
+			// addresses skipped
+			c, found := k.GetClockContract(ctx, registered)
+			require.True(t, found)
+			require.Equal(t, uint64(200_000), c.GasLimit)
+			c, found = k.GetClockContract(ctx, listed)
+			require.True(t, found)
+			require.Equal(t, types.NewClockContract(listed, tc.expGasLimit), c)
+			require.Len(t, k.GetAllClockContracts(ctx), 2)
+		})
+	}
+
+	// a chain without legacy params gets the default params
+	ctx, k, key := setupKeeper(t, newMockContractKeeper())
+	require.NoError(t, keeper.NewMigrator(k).Migrate1to2(ctx))
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+
+	// malformed legacy params fail the migration
+	ctx.KVStore(key).Set(types.LegacyParamsKey, []byte{0x0a, 0x05, 'a'})
+	require.Error(t, keeper.NewMigrator(k).Migrate1to2(ctx))
+}
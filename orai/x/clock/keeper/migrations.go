@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/oraichain/orai/x/clock/types"
+)
+
+// Migrator migrates the state of the clock module.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(k Keeper) Migrator {
+	return Migrator{keeper: k}
+}
+
+// Migrate1to2 migrates the params of the Juno clock module this module
+// replaces. The params move to the params subspace, keeping the contract gas
+// limit, and the contracts they list are registered with that gas limit.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	store := ctx.KVStore(m.keeper.storeKey)
+	params := types.DefaultParams()
+
+	var contracts []string
+	if bz := store.Get(types.LegacyParamsKey); bz != nil {
+		var (
+			gasLimit uint64
+			err      error
+		)
+		contracts, gasLimit, err = decodeLegacyParams(bz)
+		if err != nil {
+			return err
+		}
+		if gasLimit >= types.MinContractGasLimit {
+			params.ContractGasLimit = gasLimit
+		}
+		store.Delete(types.LegacyParamsKey)
+	}
+
+	m.keeper.SetParams(ctx, params)
+
+	for _, addr := range contracts {
+		contract, err := sdk.AccAddressFromBech32(addr)
+		if err != nil {
+			m.keeper.Logger(ctx).Error("skipped invalid clock contract", "contract", addr, "err", err)
+			continue
+		}
+		if _, found := m.keeper.GetClockContract(ctx, contract); found {
+			continue
+		}
+		m.keeper.SetClockContract(ctx, types.NewClockContract(contract, params.ContractGasLimit))
+	}
+
+	return nil
+}
+
+// decodeLegacyParams decodes the params of the Juno clock module:
+//
+//	message Params {
+//	  repeated string contract_addresses = 1;
+//	  uint64 contract_gas_limit = 2;
+//	}
+func decodeLegacyParams(bz []byte) (contracts []string, gasLimit uint64, err error) {
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		if n < 0 {
+			return nil, 0, fmt.Errorf("invalid legacy clock params: %w", protowire.ParseError(n))
+		}
+		bz = bz[n:]
+
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			v, n := protowire.ConsumeString(bz)
+			if n < 0 {
+				return nil, 0, fmt.Errorf("invalid legacy clock contract: %w", protowire.ParseError(n))
+			}
+			contracts = append(contracts, v)
+			bz = bz[n:]
+		case num == 2 && typ == protowire.VarintType:
+			v, n := protowire.ConsumeVarint(bz)
+			if n < 0 {
+				return nil, 0, fmt.Errorf("invalid legacy clock gas limit: %w", protowire.ParseError(n))
+			}
+			gasLimit = v
+			bz = bz[n:]
+		default:
+			n := protowire.ConsumeFieldValue(num, typ, bz)
+			if n < 0 {
+				return nil, 0, fmt.Errorf("invalid legacy clock params: %w", protowire.ParseError(n))
+			}
+			bz = bz[n:]
+		}
+	}
+	return contracts, gasLimit, nil
+}
@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/oraichain/orai/x/clock/types"
+)
+
+var _ types.MsgServer = &msgServer{}
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the clock MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{Keeper: k}
+}
+
+func (k msgServer) RegisterClockContract(goCtx context.Context, msg *types.MsgRegisterClockContract) (*types.MsgRegisterClockContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, contract, err := k.authorize(ctx, msg.SenderAddress, msg.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+	if _, found := k.GetClockContract(ctx, contract); found {
+		return nil, sdkerrors.Wrap(types.ErrContractRegistered, msg.ContractAddress)
+	}
+
+	maxGasLimit := k.GetParams(ctx).ContractGasLimit
+	gasLimit := msg.GasLimit
+	if gasLimit == 0 {
+		gasLimit = maxGasLimit
+	}
+	if gasLimit < types.MinContractGasLimit || gasLimit > maxGasLimit {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidGasLimit, "must be between %d and %d: %d", types.MinContractGasLimit, maxGasLimit, gasLimit)
+	}
+
+	k.SetClockContract(ctx, types.NewClockContract(contract, gasLimit))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterClockContract,
+			sdk.NewAttribute(types.AttributeKeyContract, msg.ContractAddress),
+			sdk.NewAttribute(types.AttributeKeyGasLimit, strconv.FormatUint(gasLimit, 10)),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		),
+	)
+
+	return &types.MsgRegisterClockContractResponse{}, nil
+}
+
+func (k msgServer) UnregisterClockContract(goCtx context.Context, msg *types.MsgUnregisterClockContract) (*types.MsgUnregisterClockContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, contract, err := k.authorize(ctx, msg.SenderAddress, msg.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+	if _, found := k.GetClockContract(ctx, contract); !found {
+		return nil, sdkerrors.Wrap(types.ErrContractNotRegistered, msg.ContractAddress)
+	}
+
+	k.DeleteClockContract(ctx, contract)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnregisterClockContract,
+			sdk.NewAttribute(types.AttributeKeyContract, msg.ContractAddress),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		),
+	)
+
+	return &types.MsgUnregisterClockContractResponse{}, nil
+}
+
+func (k msgServer) UnjailClockContract(goCtx context.Context, msg *types.MsgUnjailClockContract) (*types.MsgUnjailClockContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, contract, err := k.authorize(ctx, msg.SenderAddress, msg.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+	c, found := k.GetClockContract(ctx, contract)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrContractNotRegistered, msg.ContractAddress)
+	}
+	if !c.IsJailed {
+		return nil, sdkerrors.Wrap(types.ErrContractNotJailed, msg.ContractAddress)
+	}
+
+	c.IsJailed = false
+	c.ErrorCount = 0
+	k.SetClockContract(ctx, c)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnjailClockContract,
+			sdk.NewAttribute(types.AttributeKeyContract, msg.ContractAddress),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		),
+	)
+
+	return &types.MsgUnjailClockContractResponse{}, nil
+}
+
+func (k msgServer) authorize(ctx sdk.Context, senderBech32, contractBech32 string) (sender, contract sdk.AccAddress, err error) {
+	if sender, err = sdk.AccAddressFromBech32(senderBech32); err != nil {
+		return nil, nil, err
+	}
+	if contract, err = sdk.AccAddressFromBech32(contractBech32); err != nil {
+		return nil, nil, err
+	}
+	if err := k.IsAuthorized(ctx, sender, contract); err != nil {
+		return nil, nil, err
+	}
+	return sender, contract, nil
+}
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/oraichain/orai/x/clock/types"
+)
+
+var _ types.QueryServer = &Querier{}
+
+type Querier struct {
+	keeper Keeper
+}
+
+func NewQuerier(k Keeper) Querier {
+	return Querier{
+		keeper: k,
+	}
+}
+
+// Params returns the clock parameters.
+func (q Querier) Params(stdCtx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+
+	return &types.QueryParamsResponse{
+		Params: q.keeper.GetParams(ctx),
+	}, nil
+}
+
+// ClockContracts returns all registered contracts.
+func (q Querier) ClockContracts(stdCtx context.Context, req *types.QueryClockContractsRequest) (*types.QueryClockContractsResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	contracts, pageRes, err := q.clockContracts(ctx, req.Pagination, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryClockContractsResponse{
+		ClockContracts: contracts,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// JailedClockContracts returns the jailed contracts.
+func (q Querier) JailedClockContracts(stdCtx context.Context, req *types.QueryJailedClockContractsRequest) (*types.QueryJailedClockContractsResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	contracts, pageRes, err := q.clockContracts(ctx, req.Pagination, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryJailedClockContractsResponse{
+		ClockContracts: contracts,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// ClockContract returns a registered contract.
+func (q Querier) ClockContract(stdCtx context.Context, req *types.QueryClockContractRequest) (*types.QueryClockContractResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	contract, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	c, found := q.keeper.GetClockContract(ctx, contract)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrContractNotRegistered, req.ContractAddress)
+	}
+
+	return &types.QueryClockContractResponse{
+		ClockContract: c,
+	}, nil
+}
+
+// clockContracts returns a page of the registered contracts, only the jailed
+// ones if jailedOnly is set.
+func (q Querier) clockContracts(ctx sdk.Context, pagination *query.PageRequest, jailedOnly bool) ([]types.ClockContract, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(q.keeper.storeKey), types.ClockContractPrefix)
+
+	var contracts []types.ClockContract
+	pageRes, err := query.FilteredPaginate(store, pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var c types.ClockContract
+		if err := q.keeper.cdc.Unmarshal(value, &c); err != nil {
+			return false, err
+		}
+		if jailedOnly && !c.IsJailed {
+			return false, nil
+		}
+		if accumulate {
+			contracts = append(contracts, c)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return contracts, pageRes, nil
+}
@@ -0,0 +1,26 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewClockContract creates a new registration of a contract.
+func NewClockContract(contract sdk.AccAddress, gasLimit uint64) ClockContract {
+	return ClockContract{
+		ContractAddress: contract.String(),
+		GasLimit:        gasLimit,
+	}
+}
+
+// Validate performs basic validation.
+func (c ClockContract) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(c.ContractAddress); err != nil {
+		return fmt.Errorf("invalid contract address %s: %w", c.ContractAddress, err)
+	}
+	if c.GasLimit < MinContractGasLimit {
+		return fmt.Errorf("gas limit of %s must be at least %d: %d", c.ContractAddress, MinContractGasLimit, c.GasLimit)
+	}
+	return nil
+}
@@ -0,0 +1,442 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: orai/clock/v1/clock.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ClockContract is a contract called with the clock_end_block sudo msg at
+// the end of every block until it is jailed.
+type ClockContract struct {
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty" yaml:"contract_address"`
+	// gas_limit is the gas the contract may use in each call.
+	GasLimit uint64 `protobuf:"varint,2,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty" yaml:"gas_limit"`
+	// error_count is the number of calls in a row that failed or ran out of
+	// gas.
+	ErrorCount uint64 `protobuf:"varint,3,opt,name=error_count,json=errorCount,proto3" json:"error_count,omitempty" yaml:"error_count"`
+	// is_jailed is set once error_count reaches the max errors, the contract is
+	// no longer called until it is unjailed.
+	IsJailed bool `protobuf:"varint,4,opt,name=is_jailed,json=isJailed,proto3" json:"is_jailed,omitempty" yaml:"is_jailed"`
+}
+
+func (m *ClockContract) Reset()         { *m = ClockContract{} }
+func (m *ClockContract) String() string { return proto.CompactTextString(m) }
+func (*ClockContract) ProtoMessage()    {}
+func (*ClockContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9f0884ec9454f15, []int{0}
+}
+func (m *ClockContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClockContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClockContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClockContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClockContract.Merge(m, src)
+}
+func (m *ClockContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClockContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClockContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClockContract proto.InternalMessageInfo
+
+func (m *ClockContract) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *ClockContract) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *ClockContract) GetErrorCount() uint64 {
+	if m != nil {
+		return m.ErrorCount
+	}
+	return 0
+}
+
+func (m *ClockContract) GetIsJailed() bool {
+	if m != nil {
+		return m.IsJailed
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*ClockContract)(nil), "orai.clock.v1.ClockContract")
+}
+
+func init() { proto.RegisterFile("orai/clock/v1/clock.proto", fileDescriptor_f9f0884ec9454f15) }
+
+var fileDescriptor_f9f0884ec9454f15 = []byte{
+	// 291 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcc, 0x2f, 0x4a, 0xcc,
+	0xd4, 0x4f, 0xce, 0xc9, 0x4f, 0xce, 0xd6, 0x2f, 0x33, 0x84, 0x30, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b,
+	0xf2, 0x85, 0x78, 0x41, 0x52, 0x7a, 0x10, 0x91, 0x32, 0x43, 0x29, 0x91, 0xf4, 0xfc, 0xf4, 0x7c,
+	0xb0, 0x8c, 0x3e, 0x88, 0x05, 0x51, 0xa4, 0xf4, 0x8d, 0x91, 0x8b, 0xd7, 0x19, 0xa4, 0xc4, 0x39,
+	0x3f, 0xaf, 0xa4, 0x28, 0x31, 0xb9, 0x44, 0xc8, 0x8d, 0x4b, 0x20, 0x19, 0xca, 0x8e, 0x4f, 0x4c,
+	0x49, 0x29, 0x4a, 0x2d, 0x2e, 0x96, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x74, 0x92, 0xfe, 0x74, 0x4f,
+	0x5e, 0xbc, 0x32, 0x31, 0x37, 0xc7, 0x4a, 0x09, 0x5d, 0x85, 0x52, 0x10, 0x3f, 0x4c, 0xc8, 0x11,
+	0x22, 0x22, 0x64, 0xc8, 0xc5, 0x99, 0x9e, 0x58, 0x1c, 0x9f, 0x93, 0x99, 0x9b, 0x59, 0x22, 0xc1,
+	0xa4, 0xc0, 0xa8, 0xc1, 0xe2, 0x24, 0xf2, 0xe9, 0x9e, 0xbc, 0x00, 0xc4, 0x00, 0xb8, 0x94, 0x52,
+	0x10, 0x47, 0x7a, 0x62, 0xb1, 0x0f, 0x88, 0x29, 0x64, 0xce, 0xc5, 0x9d, 0x5a, 0x54, 0x94, 0x5f,
+	0x14, 0x9f, 0x9c, 0x5f, 0x9a, 0x57, 0x22, 0xc1, 0x0c, 0xd6, 0x24, 0xf6, 0xe9, 0x9e, 0xbc, 0x10,
+	0x44, 0x13, 0x92, 0xa4, 0x52, 0x10, 0x17, 0x98, 0xe7, 0x0c, 0xe2, 0x80, 0xec, 0xca, 0x2c, 0x8e,
+	0xcf, 0x4a, 0xcc, 0xcc, 0x49, 0x4d, 0x91, 0x60, 0x51, 0x60, 0xd4, 0xe0, 0x40, 0xb6, 0x0b, 0x2e,
+	0xa5, 0x14, 0xc4, 0x91, 0x59, 0xec, 0x05, 0x66, 0x3a, 0x39, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1,
+	0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70,
+	0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x7a, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae,
+	0x3e, 0x28, 0x08, 0x93, 0x33, 0x12, 0x33, 0xf3, 0xc0, 0x2c, 0xfd, 0x0a, 0x68, 0x48, 0x97, 0x54,
+	0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x83, 0xd0, 0x18, 0x30, 0x00, 0x06, 0x14, 0x19, 0x28, 0x84,
+	0x01, 0x00, 0x00,
+}
+
+func (m *ClockContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClockContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClockContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsJailed {
+		i--
+		if m.IsJailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ErrorCount != 0 {
+		i = encodeVarintClock(dAtA, i, uint64(m.ErrorCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintClock(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintClock(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintClock(dAtA []byte, offset int, v uint64) int {
+	offset -= sovClock(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ClockContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovClock(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovClock(uint64(m.GasLimit))
+	}
+	if m.ErrorCount != 0 {
+		n += 1 + sovClock(uint64(m.ErrorCount))
+	}
+	if m.IsJailed {
+		n += 2
+	}
+	return n
+}
+
+func sovClock(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozClock(x uint64) (n int) {
+	return sovClock(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ClockContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClockContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClockContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCount", wireType)
+			}
+			m.ErrorCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ErrorCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsJailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsJailed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipClock(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowClock
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowClock
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowClock
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthClock
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupClock
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthClock
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthClock        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowClock          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupClock = fmt.Errorf("proto: unexpected end of group")
+)
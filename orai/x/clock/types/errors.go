@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/clock module sentinel errors
+var (
+	ErrContractNotFound      = sdkerrors.Register(ModuleName, 2, "contract not found")
+	ErrContractRegistered    = sdkerrors.Register(ModuleName, 3, "contract already registered")
+	ErrContractNotRegistered = sdkerrors.Register(ModuleName, 4, "contract not registered")
+	ErrContractNotJailed     = sdkerrors.Register(ModuleName, 5, "contract not jailed")
+	ErrInvalidGasLimit       = sdkerrors.Register(ModuleName, 6, "invalid gas limit")
+)
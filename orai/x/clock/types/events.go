@@ -0,0 +1,15 @@
+package types
+
+// clock module event types
+const (
+	EventTypeRegisterClockContract   = "register_clock_contract"
+	EventTypeUnregisterClockContract = "unregister_clock_contract"
+	EventTypeUnjailClockContract     = "unjail_clock_contract"
+	EventTypeClockContractError      = "clock_contract_error"
+	EventTypeJailClockContract       = "jail_clock_contract"
+
+	AttributeKeyContract   = "contract"
+	AttributeKeyGasLimit   = "gas_limit"
+	AttributeKeyErrorCount = "error_count"
+	AttributeKeyError      = "error"
+)
@@ -0,0 +1,35 @@
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, clockContracts []ClockContract) *GenesisState {
+	return &GenesisState{
+		Params:         params,
+		ClockContracts: clockContracts,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), []ClockContract{})
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(gs.ClockContracts))
+	for _, c := range gs.ClockContracts {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+		if seen[c.ContractAddress] {
+			return fmt.Errorf("duplicate clock contract %s", c.ContractAddress)
+		}
+		seen[c.ContractAddress] = true
+	}
+	return nil
+}
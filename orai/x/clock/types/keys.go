@@ -0,0 +1,34 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "clock"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+var (
+	// LegacyParamsKey is the store key of the params of the clock module
+	// this module replaces, migrated to the params subspace.
+	LegacyParamsKey = []byte{0x00}
+
+	ClockContractPrefix = []byte{0x01}
+)
+
+// GetClockContractKey returns the store key of a registered contract.
+func GetClockContractKey(contract sdk.AccAddress) []byte {
+	return append(ClockContractPrefix, address.MustLengthPrefix(contract)...)
+}
@@ -0,0 +1,127 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgRegisterClockContract   = "register_clock_contract"
+	TypeMsgUnregisterClockContract = "unregister_clock_contract"
+	TypeMsgUnjailClockContract     = "unjail_clock_contract"
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterClockContract{}
+	_ sdk.Msg = &MsgUnregisterClockContract{}
+	_ sdk.Msg = &MsgUnjailClockContract{}
+)
+
+// NewMsgRegisterClockContract creates a new MsgRegisterClockContract
+// instance.
+func NewMsgRegisterClockContract(sender, contract sdk.AccAddress, gasLimit uint64) *MsgRegisterClockContract {
+	return &MsgRegisterClockContract{
+		SenderAddress:   sender.String(),
+		ContractAddress: contract.String(),
+		GasLimit:        gasLimit,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgRegisterClockContract) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgRegisterClockContract) Type() string { return TypeMsgRegisterClockContract }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRegisterClockContract) ValidateBasic() error {
+	if err := validateAddresses(msg.SenderAddress, msg.ContractAddress); err != nil {
+		return err
+	}
+	if msg.GasLimit != 0 && msg.GasLimit < MinContractGasLimit {
+		return sdkerrors.Wrapf(ErrInvalidGasLimit, "must be at least %d: %d", MinContractGasLimit, msg.GasLimit)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgRegisterClockContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRegisterClockContract) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.SenderAddress)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgUnregisterClockContract creates a new MsgUnregisterClockContract
+// instance.
+func NewMsgUnregisterClockContract(sender, contract sdk.AccAddress) *MsgUnregisterClockContract {
+	return &MsgUnregisterClockContract{
+		SenderAddress:   sender.String(),
+		ContractAddress: contract.String(),
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgUnregisterClockContract) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgUnregisterClockContract) Type() string { return TypeMsgUnregisterClockContract }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgUnregisterClockContract) ValidateBasic() error {
+	return validateAddresses(msg.SenderAddress, msg.ContractAddress)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgUnregisterClockContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgUnregisterClockContract) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.SenderAddress)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgUnjailClockContract creates a new MsgUnjailClockContract instance.
+func NewMsgUnjailClockContract(sender, contract sdk.AccAddress) *MsgUnjailClockContract {
+	return &MsgUnjailClockContract{
+		SenderAddress:   sender.String(),
+		ContractAddress: contract.String(),
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgUnjailClockContract) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgUnjailClockContract) Type() string { return TypeMsgUnjailClockContract }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgUnjailClockContract) ValidateBasic() error {
+	return validateAddresses(msg.SenderAddress, msg.ContractAddress)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgUnjailClockContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgUnjailClockContract) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.SenderAddress)
+	return []sdk.AccAddress{sender}
+}
+
+func validateAddresses(sender, contract string) error {
+	if _, err := sdk.AccAddressFromBech32(sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(contract); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address: %s", err)
+	}
+	return nil
+}
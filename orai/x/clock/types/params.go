@@ -0,0 +1,85 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"gopkg.in/yaml.v2"
+)
+
+// MinContractGasLimit is the lowest contract gas limit, a contract needs
+// at least this much to run.
+const MinContractGasLimit = 100_000
+
+// Parameter store keys
+var (
+	KeyContractGasLimit = []byte("ContractGasLimit")
+	KeyMaxErrors        = []byte("MaxErrors")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable the param key table for the clock module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(contractGasLimit, maxErrors uint64) Params {
+	return Params{
+		ContractGasLimit: contractGasLimit,
+		MaxErrors:        maxErrors,
+	}
+}
+
+// DefaultParams returns default parameters, jailing the contracts after 3
+// failed calls in a row.
+func DefaultParams() Params {
+	return NewParams(1_000_000, 3)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyContractGasLimit, &p.ContractGasLimit, validateContractGasLimit),
+		paramtypes.NewParamSetPair(KeyMaxErrors, &p.MaxErrors, validateMaxErrors),
+	}
+}
+
+// Validate performs basic validation.
+func (p Params) Validate() error {
+	if err := validateContractGasLimit(p.ContractGasLimit); err != nil {
+		return err
+	}
+	return validateMaxErrors(p.MaxErrors)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+func validateContractGasLimit(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < MinContractGasLimit {
+		return fmt.Errorf("contract gas limit must be at least %d: %d", MinContractGasLimit, v)
+	}
+	return nil
+}
+
+func validateMaxErrors(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max errors must be positive")
+	}
+	return nil
+}
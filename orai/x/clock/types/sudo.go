@@ -0,0 +1,5 @@
+package types
+
+// EndBlockSudoMessage is the sudo msg sent to the registered contracts at
+// the end of every block.
+const EndBlockSudoMessage = `{"clock_end_block":{}}`
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/oraichain/orai/x/commission/types"
+)
+
+// Keeper of the commission store
+type Keeper struct {
+	paramSpace paramtypes.Subspace
+}
+
+func NewKeeper(paramSpace paramtypes.Subspace) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		paramSpace: paramSpace,
+	}
+}
+
+// GetParams returns the current x/commission module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (p types.Params) {
+	k.paramSpace.GetParamSet(ctx, &p)
+	return p
+}
+
+// SetParams sets the x/commission module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, p types.Params) {
+	k.paramSpace.SetParamSet(ctx, &p)
+}
+
+// ValidateMsg rejects staking messages that set a commission below the
+// governance-controlled floor. Other messages are ignored.
+func (k Keeper) ValidateMsg(ctx sdk.Context, m sdk.Msg) error {
+	switch msg := m.(type) {
+	case *stakingtypes.MsgCreateValidator:
+		// prevent new validators joining the set with
+		// commission set below the floor
+		p := k.GetParams(ctx)
+		c := msg.Commission
+		if c.Rate.LT(p.MinCommissionRate) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "commission can't be lower than %s", p.MinCommissionRate)
+		}
+		if c.MaxChangeRate.LT(p.MinMaxChangeRate) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "commission max change rate can't be lower than %s", p.MinMaxChangeRate)
+		}
+	case *stakingtypes.MsgEditValidator:
+		// if commission rate is nil, it means only
+		// other fields are affected - skip
+		if msg.CommissionRate == nil {
+			break
+		}
+		p := k.GetParams(ctx)
+		if msg.CommissionRate.LT(p.MinCommissionRate) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "commission can't be lower than %s", p.MinCommissionRate)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/oraichain/orai/x/commission/keeper"
+	"github.com/oraichain/orai/x/commission/types"
+)
+
+func setupKeeper(t *testing.T) (sdk.Context, keeper.Keeper) {
+	key := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	tkey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+
+	ms := store.NewCommitMultiStore(dbm.NewMemDB())
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(tkey, sdk.StoreTypeTransient, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	encCfg := simapp.MakeTestEncodingConfig()
+	paramsKeeper := paramskeeper.NewKeeper(encCfg.Marshaler, codec.NewLegacyAmino(), key, tkey)
+	k := keeper.NewKeeper(paramsKeeper.Subspace(types.ModuleName))
+
+	return sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger()), k
+}
+
+func TestParams(t *testing.T) {
+	ctx, k := setupKeeper(t)
+
+	params := types.NewParams(sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(1, 2))
+	k.SetParams(ctx, params)
+	require.Equal(t, params, k.GetParams(ctx))
+
+	k.SetParams(ctx, types.DefaultParams())
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+}
+
+func TestValidateMsg(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	k.SetParams(ctx, types.NewParams(sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(1, 2)))
+
+	valAddr := sdk.ValAddress("validator___________")
+	createValidator := func(rate, maxChangeRate sdk.Dec) sdk.Msg {
+		return &stakingtypes.MsgCreateValidator{
+			ValidatorAddress: valAddr.String(),
+			Commission:       stakingtypes.NewCommissionRates(rate, sdk.OneDec(), maxChangeRate),
+		}
+	}
+	editValidator := func(rate *sdk.Dec) sdk.Msg {
+		return stakingtypes.NewMsgEditValidator(valAddr, stakingtypes.Description{}, rate, nil)
+	}
+	decPtr := func(d sdk.Dec) *sdk.Dec { return &d }
+
+	cases := map[string]struct {
+		msg    sdk.Msg
+		expErr error
+	}{
+		"create at the floor": {
+			msg: createValidator(sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(1, 2)),
+		},
+		"create below the min commission rate": {
+			msg:    createValidator(sdk.NewDecWithPrec(4, 2), sdk.NewDecWithPrec(1, 2)),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"create below the min max change rate": {
+			msg:    createValidator(sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(9, 3)),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"edit at the floor": {
+			msg: editValidator(decPtr(sdk.NewDecWithPrec(5, 2))),
+		},
+		"edit below the floor": {
+			msg:    editValidator(decPtr(sdk.NewDecWithPrec(4, 2))),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"edit without commission rate": {
+			msg: editValidator(nil),
+		},
+		"other msg": {
+			msg: &stakingtypes.MsgDelegate{},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			require.ErrorIs(t, k.ValidateMsg(ctx, tc.msg), tc.expErr)
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/oraichain/orai/x/commission/types"
+)
+
+var _ types.QueryServer = &Querier{}
+
+type Querier struct {
+	keeper Keeper
+}
+
+func NewQuerier(k Keeper) Querier {
+	return Querier{
+		keeper: k,
+	}
+}
+
+// Params returns the commission floor parameters.
+func (q Querier) Params(stdCtx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+
+	return &types.QueryParamsResponse{
+		Params: q.keeper.GetParams(ctx),
+	}, nil
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var _ stakingtypes.MsgServer = stakingMsgServer{}
+
+// stakingMsgServer applies the commission floor before delegating to the
+// staking msg server, so that messages dispatched by authz, wasm contracts or
+// interchain accounts are validated like the ones in a tx.
+type stakingMsgServer struct {
+	stakingtypes.MsgServer
+
+	keeper Keeper
+}
+
+// NewStakingMsgServer wraps the staking msg server with the commission floor.
+func NewStakingMsgServer(k Keeper, server stakingtypes.MsgServer) stakingtypes.MsgServer {
+	return stakingMsgServer{
+		MsgServer: server,
+		keeper:    k,
+	}
+}
+
+func (s stakingMsgServer) CreateValidator(goCtx context.Context, msg *stakingtypes.MsgCreateValidator) (*stakingtypes.MsgCreateValidatorResponse, error) {
+	if err := s.keeper.ValidateMsg(sdk.UnwrapSDKContext(goCtx), msg); err != nil {
+		return nil, err
+	}
+
+	return s.MsgServer.CreateValidator(goCtx, msg)
+}
+
+func (s stakingMsgServer) EditValidator(goCtx context.Context, msg *stakingtypes.MsgEditValidator) (*stakingtypes.MsgEditValidatorResponse, error) {
+	if err := s.keeper.ValidateMsg(sdk.UnwrapSDKContext(goCtx), msg); err != nil {
+		return nil, err
+	}
+
+	return s.MsgServer.EditValidator(goCtx, msg)
+}
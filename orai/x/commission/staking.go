@@ -0,0 +1,64 @@
+package commission
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/oraichain/orai/x/commission/keeper"
+)
+
+// StakingAppModule is the staking module with the commission floor applied at
+// message execution. Every entry path (txs, authz, wasm dispatch, interchain
+// account host) ends in the msg service router, so the floor can't be
+// bypassed by wrapping the staking messages.
+type StakingAppModule struct {
+	staking.AppModule
+
+	stakingKeeper    stakingkeeper.Keeper
+	commissionKeeper keeper.Keeper
+}
+
+// NewStakingAppModule creates the wrapped staking module.
+func NewStakingAppModule(
+	cdc codec.Codec,
+	stakingKeeper stakingkeeper.Keeper,
+	ak stakingtypes.AccountKeeper,
+	bk stakingtypes.BankKeeper,
+	commissionKeeper keeper.Keeper,
+) StakingAppModule {
+	return StakingAppModule{
+		AppModule:        staking.NewAppModule(cdc, stakingKeeper, ak, bk),
+		stakingKeeper:    stakingKeeper,
+		commissionKeeper: commissionKeeper,
+	}
+}
+
+// Route returns the legacy staking route with the commission floor applied.
+func (am StakingAppModule) Route() sdk.Route {
+	route := am.AppModule.Route()
+	handler := route.Handler()
+
+	return sdk.NewRoute(route.Path(), func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		if err := am.commissionKeeper.ValidateMsg(ctx, msg); err != nil {
+			return nil, err
+		}
+		return handler(ctx, msg)
+	})
+}
+
+// RegisterServices registers the staking services, wrapping the msg server
+// with the commission floor.
+func (am StakingAppModule) RegisterServices(cfg module.Configurator) {
+	msgServer := keeper.NewStakingMsgServer(am.commissionKeeper, stakingkeeper.NewMsgServerImpl(am.stakingKeeper))
+	stakingtypes.RegisterMsgServer(cfg.MsgServer(), msgServer)
+	stakingtypes.RegisterQueryServer(cfg.QueryServer(), stakingkeeper.Querier{Keeper: am.stakingKeeper})
+
+	m := stakingkeeper.NewMigrator(am.stakingKeeper)
+	if err := cfg.RegisterMigration(stakingtypes.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,12 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "commission"
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"gopkg.in/yaml.v2"
+)
+
+// Parameter store keys
+var (
+	KeyMinCommissionRate = []byte("MinCommissionRate")
+	KeyMinMaxChangeRate  = []byte("MinMaxChangeRate")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable the param key table for the commission module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(minCommissionRate, minMaxChangeRate sdk.Dec) Params {
+	return Params{
+		MinCommissionRate: minCommissionRate,
+		MinMaxChangeRate:  minMaxChangeRate,
+	}
+}
+
+// DefaultParams returns default parameters, keeping the 3% floor that used to
+// be hard-coded in the ante handler.
+func DefaultParams() Params {
+	return Params{
+		MinCommissionRate: sdk.NewDecWithPrec(3, 2),
+		MinMaxChangeRate:  sdk.ZeroDec(),
+	}
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMinCommissionRate, &p.MinCommissionRate, validateRate),
+		paramtypes.NewParamSetPair(KeyMinMaxChangeRate, &p.MinMaxChangeRate, validateRate),
+	}
+}
+
+// Validate performs basic validation.
+func (p Params) Validate() error {
+	if err := validateRate(p.MinCommissionRate); err != nil {
+		return err
+	}
+	return validateRate(p.MinMaxChangeRate)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+func validateRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("rate cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("rate cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("rate too large: %s", v)
+	}
+
+	return nil
+}
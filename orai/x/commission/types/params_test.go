@@ -0,0 +1,45 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidate(t *testing.T) {
+	cases := map[string]struct {
+		params Params
+		expErr bool
+	}{
+		"default": {
+			params: DefaultParams(),
+		},
+		"zero floor": {
+			params: NewParams(sdk.ZeroDec(), sdk.ZeroDec()),
+		},
+		"negative commission": {
+			params: NewParams(sdk.NewDecWithPrec(-1, 2), sdk.ZeroDec()),
+			expErr: true,
+		},
+		"commission above one": {
+			params: NewParams(sdk.NewDecWithPrec(101, 2), sdk.ZeroDec()),
+			expErr: true,
+		},
+		"nil max change rate": {
+			params: Params{MinCommissionRate: sdk.ZeroDec()},
+			expErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
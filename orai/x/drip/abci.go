@@ -0,0 +1,21 @@
+package drip
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/oraichain/orai/x/drip/keeper"
+)
+
+// BeginBlocker moves the tokens deposited in the previous block to the fee
+// collector. It must run before the distribution module allocates the fees.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	// a failed move, e.g. a factory denom blocked by its before send hook,
+	// leaves the tokens in the module account rather than halting the chain
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.DistributeTokens(cacheCtx); err != nil {
+		k.Logger(ctx).Error("failed to distribute tokens", "err", err)
+		return
+	}
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
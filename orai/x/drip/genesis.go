@@ -0,0 +1,30 @@
+package drip
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/oraichain/orai/x/drip/keeper"
+	"github.com/oraichain/orai/x/drip/types"
+)
+
+// InitGenesis import module genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	ak types.AccountKeeper,
+	data types.GenesisState,
+) {
+	if err := data.Validate(); err != nil {
+		panic(err)
+	}
+
+	k.SetParams(ctx, data.Params)
+
+	// make sure the module account holding the tokens exists
+	ak.GetModuleAccount(ctx, types.ModuleName)
+}
+
+// ExportGenesis export module state
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx))
+}
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/oraichain/orai/x/drip/types"
+)
+
+// Keeper of the drip module, the tokens to distribute are held by its module
+// account
+type Keeper struct {
+	paramSpace paramtypes.Subspace
+
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+}
+
+func NewKeeper(
+	paramSpace paramtypes.Subspace,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		paramSpace:    paramSpace,
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns the current x/drip module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (p types.Params) {
+	k.paramSpace.GetParamSet(ctx, &p)
+	return p
+}
+
+// SetParams sets the x/drip module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, p types.Params) {
+	k.paramSpace.SetParamSet(ctx, &p)
+}
+
+// DripTokens deposits the tokens of an allowed sender into the module
+// account, to be distributed at the next block.
+func (k Keeper) DripTokens(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coins) error {
+	params := k.GetParams(ctx)
+	if !params.EnableDrip {
+		return types.ErrDripDisabled
+	}
+	if !params.IsAllowedAddress(sender) {
+		return sdkerrors.Wrap(types.ErrDripNotAllowed, sender.String())
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDripTokens,
+			sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// DistributeTokens moves the tokens of the module account to the fee
+// collector, so that the distribution module allocates them to the stakers
+// like the fees of the previous block.
+func (k Keeper) DistributeTokens(ctx sdk.Context) error {
+	moduleAcc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	amount := k.bankKeeper.GetAllBalances(ctx, moduleAcc.GetAddress())
+	if amount.IsZero() {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, amount); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDistributeTokens,
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/oraichain/orai/x/drip/types"
+)
+
+var _ types.MsgServer = &msgServer{}
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the drip MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{Keeper: k}
+}
+
+func (k msgServer) DistributeTokens(goCtx context.Context, msg *types.MsgDistributeTokens) (*types.MsgDistributeTokensResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.SenderAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.DripTokens(ctx, sender, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDistributeTokensResponse{}, nil
+}
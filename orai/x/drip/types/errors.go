@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/drip module sentinel errors
+var (
+	ErrDripDisabled   = sdkerrors.Register(ModuleName, 2, "drip is disabled")
+	ErrDripNotAllowed = sdkerrors.Register(ModuleName, 3, "address is not allowed to distribute tokens")
+)
@@ -0,0 +1,10 @@
+package types
+
+// drip module event types
+const (
+	EventTypeDripTokens       = "drip_tokens"
+	EventTypeDistributeTokens = "distribute_tokens"
+
+	AttributeKeySender = "sender"
+	AttributeKeyAmount = "amount"
+)
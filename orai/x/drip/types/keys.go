@@ -0,0 +1,13 @@
+package types
+
+const (
+	// ModuleName defines the module name, also the name of the module account
+	// holding the tokens until they are distributed
+	ModuleName = "drip"
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
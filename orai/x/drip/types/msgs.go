@@ -0,0 +1,48 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgDistributeTokens = "distribute_tokens"
+)
+
+var _ sdk.Msg = &MsgDistributeTokens{}
+
+// NewMsgDistributeTokens creates a new MsgDistributeTokens instance.
+func NewMsgDistributeTokens(sender sdk.AccAddress, amount sdk.Coins) *MsgDistributeTokens {
+	return &MsgDistributeTokens{
+		SenderAddress: sender.String(),
+		Amount:        amount,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgDistributeTokens) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgDistributeTokens) Type() string { return TypeMsgDistributeTokens }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgDistributeTokens) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.SenderAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgDistributeTokens) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgDistributeTokens) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.SenderAddress)
+	return []sdk.AccAddress{sender}
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"gopkg.in/yaml.v2"
+)
+
+// Parameter store keys
+var (
+	KeyEnableDrip       = []byte("EnableDrip")
+	KeyAllowedAddresses = []byte("AllowedAddresses")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable the param key table for the drip module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(enableDrip bool, allowedAddresses []string) Params {
+	return Params{
+		EnableDrip:       enableDrip,
+		AllowedAddresses: allowedAddresses,
+	}
+}
+
+// DefaultParams returns default parameters, allowing no address.
+func DefaultParams() Params {
+	return NewParams(true, []string{})
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyEnableDrip, &p.EnableDrip, validateBool),
+		paramtypes.NewParamSetPair(KeyAllowedAddresses, &p.AllowedAddresses, validateAddresses),
+	}
+}
+
+// Validate performs basic validation.
+func (p Params) Validate() error {
+	if err := validateBool(p.EnableDrip); err != nil {
+		return err
+	}
+	return validateAddresses(p.AllowedAddresses)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// IsAllowedAddress reports whether an address may distribute tokens.
+func (p Params) IsAllowedAddress(addr sdk.AccAddress) bool {
+	for _, allowed := range p.AllowedAddresses {
+		if allowed == addr.String() {
+			return true
+		}
+	}
+	return false
+}
+
+func validateBool(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateAddresses(i interface{}) error {
+	addrs, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(addrs))
+	for _, addr := range addrs {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid allowed address %s: %w", addr, err)
+		}
+		if seen[addr] {
+			return fmt.Errorf("duplicate allowed address %s", addr)
+		}
+		seen[addr] = true
+	}
+	return nil
+}
@@ -0,0 +1,600 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: orai/drip/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgDistributeTokens is sent by an allowed address.
+type MsgDistributeTokens struct {
+	SenderAddress string                                   `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty" yaml:"sender_address"`
+	Amount        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgDistributeTokens) Reset()         { *m = MsgDistributeTokens{} }
+func (m *MsgDistributeTokens) String() string { return proto.CompactTextString(m) }
+func (*MsgDistributeTokens) ProtoMessage()    {}
+func (*MsgDistributeTokens) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ac26d50f35aa95e, []int{0}
+}
+func (m *MsgDistributeTokens) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDistributeTokens) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDistributeTokens.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDistributeTokens) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDistributeTokens.Merge(m, src)
+}
+func (m *MsgDistributeTokens) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDistributeTokens) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDistributeTokens.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDistributeTokens proto.InternalMessageInfo
+
+func (m *MsgDistributeTokens) GetSenderAddress() string {
+	if m != nil {
+		return m.SenderAddress
+	}
+	return ""
+}
+
+func (m *MsgDistributeTokens) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgDistributeTokensResponse defines the Msg/DistributeTokens response type.
+type MsgDistributeTokensResponse struct {
+}
+
+func (m *MsgDistributeTokensResponse) Reset()         { *m = MsgDistributeTokensResponse{} }
+func (m *MsgDistributeTokensResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDistributeTokensResponse) ProtoMessage()    {}
+func (*MsgDistributeTokensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ac26d50f35aa95e, []int{1}
+}
+func (m *MsgDistributeTokensResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDistributeTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDistributeTokensResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDistributeTokensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDistributeTokensResponse.Merge(m, src)
+}
+func (m *MsgDistributeTokensResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDistributeTokensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDistributeTokensResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDistributeTokensResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgDistributeTokens)(nil), "orai.drip.v1.MsgDistributeTokens")
+	proto.RegisterType((*MsgDistributeTokensResponse)(nil), "orai.drip.v1.MsgDistributeTokensResponse")
+}
+
+func init() { proto.RegisterFile("orai/drip/v1/tx.proto", fileDescriptor_9ac26d50f35aa95e) }
+
+var fileDescriptor_9ac26d50f35aa95e = []byte{
+	// 332 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x51, 0x4d, 0x4e, 0xc2, 0x40,
+	0x14, 0x6e, 0x25, 0x21, 0x71, 0xfc, 0x89, 0xa9, 0x92, 0x00, 0xc6, 0x01, 0xbb, 0x30, 0xb8, 0x70,
+	0xc6, 0xe2, 0xce, 0x15, 0xa2, 0x5b, 0x36, 0xc4, 0x95, 0x1b, 0x9d, 0xb6, 0x93, 0x32, 0xc1, 0xce,
+	0x34, 0x7d, 0x03, 0x81, 0x5b, 0x78, 0x0e, 0xcf, 0xe0, 0x01, 0x58, 0xb2, 0x74, 0x85, 0x06, 0x6e,
+	0xe0, 0x09, 0x4c, 0x3b, 0x35, 0xc1, 0x48, 0xe2, 0x6a, 0xde, 0x7c, 0xdf, 0x9b, 0x6f, 0xbe, 0xef,
+	0x3d, 0x54, 0x51, 0x29, 0x13, 0x34, 0x4c, 0x45, 0x42, 0xc7, 0x1e, 0xd5, 0x13, 0x92, 0xa4, 0x4a,
+	0x2b, 0x67, 0x37, 0x83, 0x49, 0x06, 0x93, 0xb1, 0x57, 0x3f, 0x8a, 0x54, 0xa4, 0x72, 0x82, 0x66,
+	0x95, 0xe9, 0xa9, 0xe3, 0x40, 0x41, 0xac, 0x80, 0xfa, 0x0c, 0x38, 0x1d, 0x7b, 0x3e, 0xd7, 0xcc,
+	0xa3, 0x81, 0x12, 0xd2, 0xf0, 0xee, 0x9b, 0x8d, 0x0e, 0x7b, 0x10, 0xdd, 0x09, 0xd0, 0xa9, 0xf0,
+	0x47, 0x9a, 0xdf, 0xab, 0x21, 0x97, 0xe0, 0x74, 0xd0, 0x3e, 0x70, 0x19, 0xf2, 0xf4, 0x91, 0x85,
+	0x61, 0xca, 0x01, 0xaa, 0x76, 0xd3, 0x6e, 0x6d, 0x77, 0x6b, 0x5f, 0x8b, 0x46, 0x65, 0xca, 0xe2,
+	0xe7, 0x6b, 0xf7, 0x37, 0xef, 0xf6, 0xf7, 0x0c, 0x70, 0x63, 0xee, 0x4e, 0x80, 0xca, 0x2c, 0x56,
+	0x23, 0xa9, 0xab, 0x5b, 0xcd, 0x52, 0x6b, 0xa7, 0x5d, 0x23, 0xc6, 0x0a, 0xc9, 0xac, 0x90, 0xc2,
+	0x0a, 0xb9, 0x55, 0x42, 0x76, 0x2f, 0x67, 0x8b, 0x86, 0xf5, 0xfa, 0xd1, 0x68, 0x45, 0x42, 0x0f,
+	0x46, 0x3e, 0x09, 0x54, 0x4c, 0x0b, 0xdf, 0xe6, 0xb8, 0x80, 0x70, 0x48, 0xf5, 0x34, 0xe1, 0x90,
+	0x3f, 0x80, 0x7e, 0x21, 0xed, 0x9e, 0xa0, 0xe3, 0x0d, 0xee, 0xfb, 0x1c, 0x12, 0x25, 0x81, 0xb7,
+	0x23, 0x54, 0xea, 0x41, 0xe4, 0x3c, 0xa1, 0x83, 0x3f, 0x01, 0x4f, 0xc9, 0xfa, 0xf4, 0xc8, 0x06,
+	0x95, 0xfa, 0xf9, 0xbf, 0x2d, 0x3f, 0x1f, 0x75, 0x3b, 0xb3, 0x25, 0xb6, 0xe7, 0x4b, 0x6c, 0x7f,
+	0x2e, 0xb1, 0xfd, 0xb2, 0xc2, 0xd6, 0x7c, 0x85, 0xad, 0xf7, 0x15, 0xb6, 0x1e, 0xce, 0xd6, 0x32,
+	0x65, 0x72, 0xc1, 0x80, 0x09, 0x99, 0x57, 0x74, 0x62, 0x56, 0x9a, 0xe7, 0xf2, 0xcb, 0xf9, 0x3e,
+	0xae, 0xbe, 0x07, 0x00, 0x27, 0x8b, 0xae, 0x5e, 0xec, 0x01, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// DistributeTokens deposits tokens distributed to the stakers at the next
+	// block.
+	DistributeTokens(ctx context.Context, in *MsgDistributeTokens, opts ...grpc.CallOption) (*MsgDistributeTokensResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) DistributeTokens(ctx context.Context, in *MsgDistributeTokens, opts ...grpc.CallOption) (*MsgDistributeTokensResponse, error) {
+	out := new(MsgDistributeTokensResponse)
+	err := c.cc.Invoke(ctx, "/orai.drip.v1.Msg/DistributeTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// DistributeTokens deposits tokens distributed to the stakers at the next
+	// block.
+	DistributeTokens(context.Context, *MsgDistributeTokens) (*MsgDistributeTokensResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) DistributeTokens(ctx context.Context, req *MsgDistributeTokens) (*MsgDistributeTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DistributeTokens not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_DistributeTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDistributeTokens)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DistributeTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orai.drip.v1.Msg/DistributeTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DistributeTokens(ctx, req.(*MsgDistributeTokens))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "orai.drip.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DistributeTokens",
+			Handler:    _Msg_DistributeTokens_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "orai/drip/v1/tx.proto",
+}
+
+func (m *MsgDistributeTokens) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDistributeTokens) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDistributeTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDistributeTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDistributeTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDistributeTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgDistributeTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDistributeTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgDistributeTokens) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDistributeTokens: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDistributeTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDistributeTokensResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDistributeTokensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDistributeTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
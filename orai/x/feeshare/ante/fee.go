@@ -0,0 +1,60 @@
+package ante
+
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/oraichain/orai/app/walker"
+)
+
+// FeeShareKeeper defines the expected feeshare keeper.
+type FeeShareKeeper interface {
+	DistributeFeeShares(ctx sdk.Context, fees sdk.Coins, contracts []sdk.AccAddress) error
+}
+
+// DistributeFeeShareDecorator pays the developer shares of the fees of a tx
+// to the withdrawers of the registered contracts it executes. It must run
+// after the fees are deducted into the fee collector. Wrapped executions
+// count as well, so that wrapping them in authz does not lose the share.
+type DistributeFeeShareDecorator struct {
+	walker         walker.Walker
+	feeShareKeeper FeeShareKeeper
+}
+
+func NewDistributeFeeShareDecorator(msgWalker walker.Walker, feeShareKeeper FeeShareKeeper) DistributeFeeShareDecorator {
+	return DistributeFeeShareDecorator{
+		walker:         msgWalker,
+		feeShareKeeper: feeShareKeeper,
+	}
+}
+
+func (dfd DistributeFeeShareDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	var contracts []sdk.AccAddress
+	err = dfd.walker.Walk(feeTx.GetMsgs(), func(msg sdk.Msg, _ int) error {
+		execMsg, ok := msg.(*wasmtypes.MsgExecuteContract)
+		if !ok {
+			return nil
+		}
+		contract, err := sdk.AccAddressFromBech32(execMsg.Contract)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address: %s", err)
+		}
+		contracts = append(contracts, contract)
+		return nil
+	})
+	if err != nil {
+		return ctx, err
+	}
+
+	if err := dfd.feeShareKeeper.DistributeFeeShares(ctx, feeTx.GetFee(), contracts); err != nil {
+		return ctx, sdkerrors.Wrap(err, "failed to distribute fee shares")
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,209 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/oraichain/orai/x/feeshare/types"
+)
+
+// Keeper of the feeshare store
+type Keeper struct {
+	storeKey   sdk.StoreKey
+	cdc        codec.BinaryCodec
+	paramSpace paramtypes.Subspace
+
+	bankKeeper types.BankKeeper
+	wasmKeeper types.WasmKeeper
+}
+
+func NewKeeper(
+	storeKey sdk.StoreKey,
+	cdc codec.BinaryCodec,
+	paramSpace paramtypes.Subspace,
+	bankKeeper types.BankKeeper,
+	wasmKeeper types.WasmKeeper,
+) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:   storeKey,
+		cdc:        cdc,
+		paramSpace: paramSpace,
+		bankKeeper: bankKeeper,
+		wasmKeeper: wasmKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns the current x/feeshare module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (p types.Params) {
+	k.paramSpace.GetParamSet(ctx, &p)
+	return p
+}
+
+// SetParams sets the x/feeshare module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, p types.Params) {
+	k.paramSpace.SetParamSet(ctx, &p)
+}
+
+// SetFeeShare stores the registration of a contract and indexes it by
+// deployer and withdrawer.
+func (k Keeper) SetFeeShare(ctx sdk.Context, fs types.FeeShare) {
+	contract := sdk.MustAccAddressFromBech32(fs.ContractAddress)
+	store := ctx.KVStore(k.storeKey)
+
+	// drop the indexes of the previous registration
+	if prev, found := k.GetFeeShare(ctx, contract); found {
+		k.deleteIndexes(store, prev)
+	}
+
+	store.Set(types.GetFeeShareKey(contract), k.cdc.MustMarshal(&fs))
+	store.Set(types.GetDeployerKey(sdk.MustAccAddressFromBech32(fs.DeployerAddress), contract), []byte{})
+	store.Set(types.GetWithdrawerKey(sdk.MustAccAddressFromBech32(fs.WithdrawerAddress), contract), []byte{})
+}
+
+// GetFeeShare returns the registration of a contract.
+func (k Keeper) GetFeeShare(ctx sdk.Context, contract sdk.AccAddress) (fs types.FeeShare, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetFeeShareKey(contract))
+	if bz == nil {
+		return fs, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &fs)
+	return fs, true
+}
+
+// DeleteFeeShare removes the registration of a contract with its indexes.
+func (k Keeper) DeleteFeeShare(ctx sdk.Context, contract sdk.AccAddress) {
+	fs, found := k.GetFeeShare(ctx, contract)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetFeeShareKey(contract))
+	k.deleteIndexes(store, fs)
+}
+
+func (k Keeper) deleteIndexes(store sdk.KVStore, fs types.FeeShare) {
+	contract := sdk.MustAccAddressFromBech32(fs.ContractAddress)
+	store.Delete(types.GetDeployerKey(sdk.MustAccAddressFromBech32(fs.DeployerAddress), contract))
+	store.Delete(types.GetWithdrawerKey(sdk.MustAccAddressFromBech32(fs.WithdrawerAddress), contract))
+}
+
+// IterateFeeShares iterates over all registrations until cb returns true.
+func (k Keeper) IterateFeeShares(ctx sdk.Context, cb func(fs types.FeeShare) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FeeSharePrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var fs types.FeeShare
+		k.cdc.MustUnmarshal(iter.Value(), &fs)
+		if cb(fs) {
+			break
+		}
+	}
+}
+
+// GetAllFeeShares returns all registrations.
+func (k Keeper) GetAllFeeShares(ctx sdk.Context) []types.FeeShare {
+	feeShares := []types.FeeShare{}
+	k.IterateFeeShares(ctx, func(fs types.FeeShare) bool {
+		feeShares = append(feeShares, fs)
+		return false
+	})
+	return feeShares
+}
+
+// IsAuthorized returns nil if the sender can register the contract, which is
+// the contract itself, its admin or, when it has none, its creator.
+func (k Keeper) IsAuthorized(ctx sdk.Context, sender, contract sdk.AccAddress) error {
+	info := k.wasmKeeper.GetContractInfo(ctx, contract)
+	if info == nil {
+		return sdkerrors.Wrap(types.ErrContractNotFound, contract.String())
+	}
+
+	switch {
+	case sender.Equals(contract):
+		return nil
+	case info.Admin != "":
+		if info.Admin == sender.String() {
+			return nil
+		}
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is neither the contract nor its admin", sender)
+	case info.Creator == sender.String():
+		return nil
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is neither the contract nor its creator", sender)
+	}
+}
+
+// DistributeFeeShares sends the developer shares of the fees of a tx from
+// the fee collector to the withdrawers of the registered contracts it
+// executes. The shares are split evenly between the executions of registered
+// contracts.
+func (k Keeper) DistributeFeeShares(ctx sdk.Context, fees sdk.Coins, contracts []sdk.AccAddress) error {
+	params := k.GetParams(ctx)
+	if !params.EnableFeeShare {
+		return nil
+	}
+
+	// the registrations are looked up even without fees, so that simulations,
+	// which usually carry none, use as much gas as delivered txs
+
+	var feeShares []types.FeeShare
+	for _, contract := range contracts {
+		if fs, found := k.GetFeeShare(ctx, contract); found {
+			feeShares = append(feeShares, fs)
+		}
+	}
+	if len(feeShares) == 0 {
+		return nil
+	}
+
+	var share sdk.Coins
+	for _, fee := range fees {
+		if !params.IsAllowedDenom(fee.Denom) {
+			continue
+		}
+		amount := fee.Amount.ToDec().Mul(params.DeveloperShares).QuoInt64(int64(len(feeShares))).TruncateInt()
+		share = share.Add(sdk.NewCoin(fee.Denom, amount))
+	}
+	if share.IsZero() {
+		return nil
+	}
+
+	for _, fs := range feeShares {
+		withdrawer := sdk.MustAccAddressFromBech32(fs.WithdrawerAddress)
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, withdrawer, share); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDistributeFeeShare,
+				sdk.NewAttribute(types.AttributeKeyContract, fs.ContractAddress),
+				sdk.NewAttribute(types.AttributeKeyWithdrawer, fs.WithdrawerAddress),
+				sdk.NewAttribute(types.AttributeKeyAmount, share.String()),
+			),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/oraichain/orai/x/feeshare/types"
+)
+
+var _ types.MsgServer = &msgServer{}
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the feeshare MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{Keeper: k}
+}
+
+func (k msgServer) RegisterFeeShare(goCtx context.Context, msg *types.MsgRegisterFeeShare) (*types.MsgRegisterFeeShareResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.GetParams(ctx).EnableFeeShare {
+		return nil, types.ErrFeeShareDisabled
+	}
+
+	contract, deployer, withdrawer, err := k.parseAddresses(msg.ContractAddress, msg.DeployerAddress, msg.WithdrawerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := k.GetFeeShare(ctx, contract); found {
+		return nil, sdkerrors.Wrap(types.ErrFeeShareAlreadyExists, msg.ContractAddress)
+	}
+	if err := k.IsAuthorized(ctx, deployer, contract); err != nil {
+		return nil, err
+	}
+
+	k.SetFeeShare(ctx, types.NewFeeShare(contract, deployer, withdrawer))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterFeeShare,
+			sdk.NewAttribute(types.AttributeKeyContract, msg.ContractAddress),
+			sdk.NewAttribute(types.AttributeKeyDeployer, msg.DeployerAddress),
+			sdk.NewAttribute(types.AttributeKeyWithdrawer, msg.WithdrawerAddress),
+		),
+	)
+
+	return &types.MsgRegisterFeeShareResponse{}, nil
+}
+
+func (k msgServer) UpdateFeeShare(goCtx context.Context, msg *types.MsgUpdateFeeShare) (*types.MsgUpdateFeeShareResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.GetParams(ctx).EnableFeeShare {
+		return nil, types.ErrFeeShareDisabled
+	}
+
+	contract, deployer, withdrawer, err := k.parseAddresses(msg.ContractAddress, msg.DeployerAddress, msg.WithdrawerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	fs, err := k.deployerFeeShare(ctx, contract, deployer)
+	if err != nil {
+		return nil, err
+	}
+
+	fs.WithdrawerAddress = withdrawer.String()
+	k.SetFeeShare(ctx, fs)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateFeeShare,
+			sdk.NewAttribute(types.AttributeKeyContract, msg.ContractAddress),
+			sdk.NewAttribute(types.AttributeKeyDeployer, msg.DeployerAddress),
+			sdk.NewAttribute(types.AttributeKeyWithdrawer, msg.WithdrawerAddress),
+		),
+	)
+
+	return &types.MsgUpdateFeeShareResponse{}, nil
+}
+
+func (k msgServer) CancelFeeShare(goCtx context.Context, msg *types.MsgCancelFeeShare) (*types.MsgCancelFeeShareResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	contract, err := sdk.AccAddressFromBech32(msg.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+	deployer, err := sdk.AccAddressFromBech32(msg.DeployerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := k.deployerFeeShare(ctx, contract, deployer); err != nil {
+		return nil, err
+	}
+
+	k.DeleteFeeShare(ctx, contract)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelFeeShare,
+			sdk.NewAttribute(types.AttributeKeyContract, msg.ContractAddress),
+			sdk.NewAttribute(types.AttributeKeyDeployer, msg.DeployerAddress),
+		),
+	)
+
+	return &types.MsgCancelFeeShareResponse{}, nil
+}
+
+// deployerFeeShare returns the registration of a contract made by the
+// deployer.
+func (k msgServer) deployerFeeShare(ctx sdk.Context, contract, deployer sdk.AccAddress) (types.FeeShare, error) {
+	fs, found := k.GetFeeShare(ctx, contract)
+	if !found {
+		return fs, sdkerrors.Wrap(types.ErrFeeShareNotFound, contract.String())
+	}
+	if fs.DeployerAddress != deployer.String() {
+		return fs, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the deployer of the fee share", deployer)
+	}
+	return fs, nil
+}
+
+func (k msgServer) parseAddresses(contractBech32, deployerBech32, withdrawerBech32 string) (contract, deployer, withdrawer sdk.AccAddress, err error) {
+	if contract, err = sdk.AccAddressFromBech32(contractBech32); err != nil {
+		return nil, nil, nil, err
+	}
+	if deployer, err = sdk.AccAddressFromBech32(deployerBech32); err != nil {
+		return nil, nil, nil, err
+	}
+	if withdrawer, err = sdk.AccAddressFromBech32(withdrawerBech32); err != nil {
+		return nil, nil, nil, err
+	}
+
+	// the fee shares are sent from the fee collector, which fails for blocked
+	// addresses
+	if k.bankKeeper.BlockedAddr(withdrawer) {
+		return nil, nil, nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive fee shares", withdrawer)
+	}
+
+	return contract, deployer, withdrawer, nil
+}
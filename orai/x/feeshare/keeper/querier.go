@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/oraichain/orai/x/feeshare/types"
+)
+
+var _ types.QueryServer = &Querier{}
+
+type Querier struct {
+	keeper Keeper
+}
+
+func NewQuerier(k Keeper) Querier {
+	return Querier{
+		keeper: k,
+	}
+}
+
+// Params returns the feeshare parameters.
+func (q Querier) Params(stdCtx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+
+	return &types.QueryParamsResponse{
+		Params: q.keeper.GetParams(ctx),
+	}, nil
+}
+
+// FeeShares returns all registered contracts.
+func (q Querier) FeeShares(stdCtx context.Context, req *types.QueryFeeSharesRequest) (*types.QueryFeeSharesResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	store := prefix.NewStore(ctx.KVStore(q.keeper.storeKey), types.FeeSharePrefix)
+
+	var feeShares []types.FeeShare
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var fs types.FeeShare
+		if err := q.keeper.cdc.Unmarshal(value, &fs); err != nil {
+			return err
+		}
+		feeShares = append(feeShares, fs)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFeeSharesResponse{
+		FeeShares:  feeShares,
+		Pagination: pageRes,
+	}, nil
+}
+
+// FeeShare returns the registration of a contract.
+func (q Querier) FeeShare(stdCtx context.Context, req *types.QueryFeeShareRequest) (*types.QueryFeeShareResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	contract, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	fs, found := q.keeper.GetFeeShare(ctx, contract)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrFeeShareNotFound, req.ContractAddress)
+	}
+
+	return &types.QueryFeeShareResponse{
+		FeeShare: fs,
+	}, nil
+}
+
+// DeployerFeeShares returns the contracts registered by a deployer.
+func (q Querier) DeployerFeeShares(stdCtx context.Context, req *types.QueryDeployerFeeSharesRequest) (*types.QueryDeployerFeeSharesResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	deployer, err := sdk.AccAddressFromBech32(req.DeployerAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	contracts, pageRes, err := q.indexedContracts(ctx, types.GetDeployerPrefix(deployer), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDeployerFeeSharesResponse{
+		ContractAddresses: contracts,
+		Pagination:        pageRes,
+	}, nil
+}
+
+// WithdrawerFeeShares returns the contracts sharing their fees with a
+// withdrawer.
+func (q Querier) WithdrawerFeeShares(stdCtx context.Context, req *types.QueryWithdrawerFeeSharesRequest) (*types.QueryWithdrawerFeeSharesResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	withdrawer, err := sdk.AccAddressFromBech32(req.WithdrawerAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	contracts, pageRes, err := q.indexedContracts(ctx, types.GetWithdrawerPrefix(withdrawer), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryWithdrawerFeeSharesResponse{
+		ContractAddresses: contracts,
+		Pagination:        pageRes,
+	}, nil
+}
+
+// indexedContracts returns the contracts of an index, keyed by their length
+// prefixed addresses.
+func (q Querier) indexedContracts(ctx sdk.Context, indexPrefix []byte, pagination *query.PageRequest) ([]string, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(q.keeper.storeKey), indexPrefix)
+
+	var contracts []string
+	pageRes, err := query.Paginate(store, pagination, func(key, _ []byte) error {
+		if len(key) == 0 || len(key) != int(key[0])+1 {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "invalid index key %X", key)
+		}
+		contracts = append(contracts, sdk.AccAddress(key[1:]).String())
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return contracts, pageRes, nil
+}
@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/feeshare module sentinel errors
+var (
+	ErrFeeShareDisabled      = sdkerrors.Register(ModuleName, 2, "fee share is disabled")
+	ErrFeeShareNotFound      = sdkerrors.Register(ModuleName, 3, "fee share not found")
+	ErrFeeShareAlreadyExists = sdkerrors.Register(ModuleName, 4, "fee share already registered")
+	ErrContractNotFound      = sdkerrors.Register(ModuleName, 5, "contract not found")
+)
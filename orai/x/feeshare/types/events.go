@@ -0,0 +1,14 @@
+package types
+
+// feeshare module event types
+const (
+	EventTypeRegisterFeeShare   = "register_feeshare"
+	EventTypeUpdateFeeShare     = "update_feeshare"
+	EventTypeCancelFeeShare     = "cancel_feeshare"
+	EventTypeDistributeFeeShare = "distribute_feeshare"
+
+	AttributeKeyContract   = "contract"
+	AttributeKeyDeployer   = "deployer"
+	AttributeKeyWithdrawer = "withdrawer"
+	AttributeKeyAmount     = "amount"
+)
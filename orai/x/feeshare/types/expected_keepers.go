@@ -0,0 +1,19 @@
+package types
+
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// WasmKeeper defines the expected wasm keeper used to authorize the
+// deployers of contracts.
+type WasmKeeper interface {
+	GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo
+}
+
+// BankKeeper defines the expected bank keeper, paying the fee shares from
+// the fee collector.
+type BankKeeper interface {
+	BlockedAddr(addr sdk.AccAddress) bool
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
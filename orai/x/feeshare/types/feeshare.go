@@ -0,0 +1,30 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewFeeShare creates a new FeeShare instance.
+func NewFeeShare(contract, deployer, withdrawer sdk.AccAddress) FeeShare {
+	return FeeShare{
+		ContractAddress:   contract.String(),
+		DeployerAddress:   deployer.String(),
+		WithdrawerAddress: withdrawer.String(),
+	}
+}
+
+// Validate performs basic validation of a registration.
+func (fs FeeShare) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(fs.ContractAddress); err != nil {
+		return fmt.Errorf("invalid contract address: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(fs.DeployerAddress); err != nil {
+		return fmt.Errorf("invalid deployer address: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(fs.WithdrawerAddress); err != nil {
+		return fmt.Errorf("invalid withdrawer address: %w", err)
+	}
+	return nil
+}
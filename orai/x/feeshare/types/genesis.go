@@ -0,0 +1,36 @@
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, feeShares []FeeShare) *GenesisState {
+	return &GenesisState{
+		Params:    params,
+		FeeShares: feeShares,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), []FeeShare{})
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(gs.FeeShares))
+	for _, fs := range gs.FeeShares {
+		if err := fs.Validate(); err != nil {
+			return err
+		}
+		if seen[fs.ContractAddress] {
+			return fmt.Errorf("duplicate fee share for %s", fs.ContractAddress)
+		}
+		seen[fs.ContractAddress] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "feeshare"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+var (
+	FeeSharePrefix   = []byte{0x01}
+	DeployerPrefix   = []byte{0x02}
+	WithdrawerPrefix = []byte{0x03}
+)
+
+// GetFeeShareKey returns the store key of the registration of a contract.
+func GetFeeShareKey(contract sdk.AccAddress) []byte {
+	return append(FeeSharePrefix, address.MustLengthPrefix(contract)...)
+}
+
+// GetDeployerPrefix returns the prefix of the contracts registered by a
+// deployer.
+func GetDeployerPrefix(deployer sdk.AccAddress) []byte {
+	return append(DeployerPrefix, address.MustLengthPrefix(deployer)...)
+}
+
+// GetDeployerKey returns the store key marking a contract registered by a
+// deployer.
+func GetDeployerKey(deployer, contract sdk.AccAddress) []byte {
+	return append(GetDeployerPrefix(deployer), address.MustLengthPrefix(contract)...)
+}
+
+// GetWithdrawerPrefix returns the prefix of the contracts sharing their fees
+// with a withdrawer.
+func GetWithdrawerPrefix(withdrawer sdk.AccAddress) []byte {
+	return append(WithdrawerPrefix, address.MustLengthPrefix(withdrawer)...)
+}
+
+// GetWithdrawerKey returns the store key marking a contract sharing its fees
+// with a withdrawer.
+func GetWithdrawerKey(withdrawer, contract sdk.AccAddress) []byte {
+	return append(GetWithdrawerPrefix(withdrawer), address.MustLengthPrefix(contract)...)
+}
@@ -0,0 +1,129 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgRegisterFeeShare = "register_feeshare"
+	TypeMsgUpdateFeeShare   = "update_feeshare"
+	TypeMsgCancelFeeShare   = "cancel_feeshare"
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterFeeShare{}
+	_ sdk.Msg = &MsgUpdateFeeShare{}
+	_ sdk.Msg = &MsgCancelFeeShare{}
+)
+
+// NewMsgRegisterFeeShare creates a new MsgRegisterFeeShare instance.
+func NewMsgRegisterFeeShare(contract, deployer, withdrawer sdk.AccAddress) *MsgRegisterFeeShare {
+	return &MsgRegisterFeeShare{
+		ContractAddress:   contract.String(),
+		DeployerAddress:   deployer.String(),
+		WithdrawerAddress: withdrawer.String(),
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgRegisterFeeShare) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgRegisterFeeShare) Type() string { return TypeMsgRegisterFeeShare }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRegisterFeeShare) ValidateBasic() error {
+	return validateAddresses(msg.ContractAddress, msg.DeployerAddress, msg.WithdrawerAddress)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgRegisterFeeShare) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRegisterFeeShare) GetSigners() []sdk.AccAddress {
+	deployer, _ := sdk.AccAddressFromBech32(msg.DeployerAddress)
+	return []sdk.AccAddress{deployer}
+}
+
+// NewMsgUpdateFeeShare creates a new MsgUpdateFeeShare instance.
+func NewMsgUpdateFeeShare(contract, deployer, withdrawer sdk.AccAddress) *MsgUpdateFeeShare {
+	return &MsgUpdateFeeShare{
+		ContractAddress:   contract.String(),
+		DeployerAddress:   deployer.String(),
+		WithdrawerAddress: withdrawer.String(),
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgUpdateFeeShare) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgUpdateFeeShare) Type() string { return TypeMsgUpdateFeeShare }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgUpdateFeeShare) ValidateBasic() error {
+	return validateAddresses(msg.ContractAddress, msg.DeployerAddress, msg.WithdrawerAddress)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgUpdateFeeShare) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgUpdateFeeShare) GetSigners() []sdk.AccAddress {
+	deployer, _ := sdk.AccAddressFromBech32(msg.DeployerAddress)
+	return []sdk.AccAddress{deployer}
+}
+
+// NewMsgCancelFeeShare creates a new MsgCancelFeeShare instance.
+func NewMsgCancelFeeShare(contract, deployer sdk.AccAddress) *MsgCancelFeeShare {
+	return &MsgCancelFeeShare{
+		ContractAddress: contract.String(),
+		DeployerAddress: deployer.String(),
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgCancelFeeShare) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgCancelFeeShare) Type() string { return TypeMsgCancelFeeShare }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCancelFeeShare) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.ContractAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.DeployerAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid deployer address: %s", err)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgCancelFeeShare) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCancelFeeShare) GetSigners() []sdk.AccAddress {
+	deployer, _ := sdk.AccAddressFromBech32(msg.DeployerAddress)
+	return []sdk.AccAddress{deployer}
+}
+
+func validateAddresses(contract, deployer, withdrawer string) error {
+	if _, err := sdk.AccAddressFromBech32(contract); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(deployer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid deployer address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(withdrawer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid withdrawer address: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"gopkg.in/yaml.v2"
+)
+
+// Parameter store keys
+var (
+	KeyEnableFeeShare  = []byte("EnableFeeShare")
+	KeyDeveloperShares = []byte("DeveloperShares")
+	KeyAllowedDenoms   = []byte("AllowedDenoms")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable the param key table for the feeshare module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(enableFeeShare bool, developerShares sdk.Dec, allowedDenoms []string) Params {
+	return Params{
+		EnableFeeShare:  enableFeeShare,
+		DeveloperShares: developerShares,
+		AllowedDenoms:   allowedDenoms,
+	}
+}
+
+// DefaultParams returns default parameters, sharing half of the fees in any
+// denom.
+func DefaultParams() Params {
+	return NewParams(true, sdk.NewDecWithPrec(50, 2), []string{})
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyEnableFeeShare, &p.EnableFeeShare, validateBool),
+		paramtypes.NewParamSetPair(KeyDeveloperShares, &p.DeveloperShares, validateShares),
+		paramtypes.NewParamSetPair(KeyAllowedDenoms, &p.AllowedDenoms, validateDenoms),
+	}
+}
+
+// Validate performs basic validation.
+func (p Params) Validate() error {
+	if err := validateBool(p.EnableFeeShare); err != nil {
+		return err
+	}
+	if err := validateShares(p.DeveloperShares); err != nil {
+		return err
+	}
+	return validateDenoms(p.AllowedDenoms)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// IsAllowedDenom reports whether the fees of a denom are shared.
+func (p Params) IsAllowedDenom(denom string) bool {
+	if len(p.AllowedDenoms) == 0 {
+		return true
+	}
+	for _, allowed := range p.AllowedDenoms {
+		if allowed == denom {
+			return true
+		}
+	}
+	return false
+}
+
+func validateBool(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateShares(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("developer shares cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("developer shares cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("developer shares too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateDenoms(i interface{}) error {
+	denoms, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(denoms))
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicate allowed denom %s", denom)
+		}
+		seen[denom] = true
+	}
+	return nil
+}
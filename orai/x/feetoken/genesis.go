@@ -0,0 +1,26 @@
+package feetoken
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/oraichain/orai/x/feetoken/keeper"
+	"github.com/oraichain/orai/x/feetoken/types"
+)
+
+// InitGenesis import module genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	data types.GenesisState,
+) {
+	if err := data.Validate(); err != nil {
+		panic(err)
+	}
+
+	k.SetParams(ctx, data.Params)
+}
+
+// ExportGenesis export module state
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx))
+}
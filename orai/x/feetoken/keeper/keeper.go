@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/oraichain/orai/x/feetoken/types"
+)
+
+// Keeper of the feetoken store
+type Keeper struct {
+	paramSpace paramtypes.Subspace
+	wasmKeeper types.WasmKeeper
+}
+
+func NewKeeper(paramSpace paramtypes.Subspace, wasmKeeper types.WasmKeeper) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		paramSpace: paramSpace,
+		wasmKeeper: wasmKeeper,
+	}
+}
+
+// GetParams returns the current x/feetoken module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (p types.Params) {
+	k.paramSpace.GetParamSet(ctx, &p)
+	return p
+}
+
+// SetParams sets the x/feetoken module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, p types.Params) {
+	k.paramSpace.SetParamSet(ctx, &p)
+}
+
+type exchangeRateQuery struct {
+	ExchangeRate struct {
+		Denom string `json:"denom"`
+	} `json:"exchange_rate"`
+}
+
+type exchangeRateResponse struct {
+	Rate sdk.Dec `json:"rate"`
+}
+
+// ConversionRate returns the amount of base denom one unit of the fee token
+// is worth, either from the params or from the rate contract.
+func (k Keeper) ConversionRate(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	feeToken, found := k.GetParams(ctx).FeeToken(denom)
+	if !found {
+		return sdk.Dec{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "%s is not a fee token", denom)
+	}
+
+	return k.conversionRate(ctx, feeToken)
+}
+
+func (k Keeper) conversionRate(ctx sdk.Context, feeToken types.FeeToken) (sdk.Dec, error) {
+	if feeToken.Contract == "" {
+		return feeToken.Rate, nil
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(feeToken.Contract)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	var query exchangeRateQuery
+	query.ExchangeRate.Denom = feeToken.Denom
+	req, err := json.Marshal(query)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	bz, err := k.wasmKeeper.QuerySmart(ctx, contractAddr, req)
+	if err != nil {
+		return sdk.Dec{}, sdkerrors.Wrapf(err, "query rate of %s", feeToken.Denom)
+	}
+
+	var res exchangeRateResponse
+	if err := json.Unmarshal(bz, &res); err != nil {
+		return sdk.Dec{}, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "rate of %s: %s", feeToken.Denom, err)
+	}
+	if res.Rate.IsNil() || !res.Rate.IsPositive() {
+		return sdk.Dec{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid rate for %s: %s", feeToken.Denom, res.Rate)
+	}
+
+	return res.Rate, nil
+}
+
+// ConvertFees returns the fees with every fee token converted into the base
+// denom. Coins that are not fee tokens are kept as they are.
+func (k Keeper) ConvertFees(ctx sdk.Context, fees sdk.Coins) (sdk.Coins, error) {
+	params := k.GetParams(ctx)
+	if len(params.FeeTokens) == 0 {
+		return fees, nil
+	}
+
+	converted := sdk.NewCoins()
+	for _, fee := range fees {
+		feeToken, found := params.FeeToken(fee.Denom)
+		if !found {
+			converted = converted.Add(fee)
+			continue
+		}
+
+		rate, err := k.conversionRate(ctx, feeToken)
+		if err != nil {
+			return nil, err
+		}
+
+		amount := rate.MulInt(fee.Amount).TruncateInt()
+		converted = converted.Add(sdk.NewCoin(params.BaseDenom, amount))
+	}
+
+	return converted, nil
+}
@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/oraichain/orai/x/feetoken/keeper"
+	"github.com/oraichain/orai/x/feetoken/types"
+)
+
+const (
+	usdtDenom = "ibc/C4CFF46FD6DE35CA4CF4CE031E643C8FDC9BA4B99AE598E9B0ED98FE3A2319F9"
+	atomDenom = "ibc/A2E2EEC9057A4A1C2C0A6A4C78B0239118DF5F278830F50B4A6BDD7A66506B78"
+)
+
+type mockWasmKeeper struct {
+	rates map[string]string
+}
+
+func (k mockWasmKeeper) QuerySmart(_ sdk.Context, _ sdk.AccAddress, req []byte) ([]byte, error) {
+	var query struct {
+		ExchangeRate struct {
+			Denom string `json:"denom"`
+		} `json:"exchange_rate"`
+	}
+	if err := json.Unmarshal(req, &query); err != nil {
+		return nil, err
+	}
+
+	rate, ok := k.rates[query.ExchangeRate.Denom]
+	if !ok {
+		return nil, errors.New("unknown denom")
+	}
+	return json.Marshal(map[string]string{"rate": rate})
+}
+
+func setupKeeper(t *testing.T, wasmKeeper types.WasmKeeper) (sdk.Context, keeper.Keeper) {
+	key := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	tkey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+
+	ms := store.NewCommitMultiStore(dbm.NewMemDB())
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(tkey, sdk.StoreTypeTransient, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	encCfg := simapp.MakeTestEncodingConfig()
+	paramsKeeper := paramskeeper.NewKeeper(encCfg.Marshaler, codec.NewLegacyAmino(), key, tkey)
+	k := keeper.NewKeeper(paramsKeeper.Subspace(types.ModuleName), wasmKeeper)
+
+	return sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger()), k
+}
+
+func TestConvertFees(t *testing.T) {
+	contract := sdk.AccAddress("contract____________").String()
+	ctx, k := setupKeeper(t, mockWasmKeeper{rates: map[string]string{atomDenom: "12.5"}})
+	k.SetParams(ctx, types.NewParams("orai", []types.FeeToken{
+		{Denom: usdtDenom, Rate: sdk.NewDecWithPrec(5, 1)},
+		{Denom: atomDenom, Rate: sdk.ZeroDec(), Contract: contract},
+	}))
+
+	cases := map[string]struct {
+		fees   sdk.Coins
+		expFee sdk.Coins
+	}{
+		"base denom": {
+			fees:   sdk.NewCoins(sdk.NewInt64Coin("orai", 100)),
+			expFee: sdk.NewCoins(sdk.NewInt64Coin("orai", 100)),
+		},
+		"fixed rate": {
+			fees:   sdk.NewCoins(sdk.NewInt64Coin(usdtDenom, 101)),
+			expFee: sdk.NewCoins(sdk.NewInt64Coin("orai", 50)),
+		},
+		"contract rate": {
+			fees:   sdk.NewCoins(sdk.NewInt64Coin(atomDenom, 2)),
+			expFee: sdk.NewCoins(sdk.NewInt64Coin("orai", 25)),
+		},
+		"mixed": {
+			fees:   sdk.NewCoins(sdk.NewInt64Coin("orai", 1), sdk.NewInt64Coin(atomDenom, 2), sdk.NewInt64Coin(usdtDenom, 10)),
+			expFee: sdk.NewCoins(sdk.NewInt64Coin("orai", 31)),
+		},
+		"not whitelisted": {
+			fees:   sdk.NewCoins(sdk.NewInt64Coin("uatom", 10)),
+			expFee: sdk.NewCoins(sdk.NewInt64Coin("uatom", 10)),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			fees, err := k.ConvertFees(ctx, tc.fees)
+			require.NoError(t, err)
+			require.Equal(t, tc.expFee, fees)
+		})
+	}
+}
+
+func TestConversionRateContractFailure(t *testing.T) {
+	contract := sdk.AccAddress("contract____________").String()
+	ctx, k := setupKeeper(t, mockWasmKeeper{rates: map[string]string{usdtDenom: "-1"}})
+	k.SetParams(ctx, types.NewParams("orai", []types.FeeToken{
+		{Denom: usdtDenom, Rate: sdk.ZeroDec(), Contract: contract},
+		{Denom: atomDenom, Rate: sdk.ZeroDec(), Contract: contract},
+	}))
+
+	_, err := k.ConversionRate(ctx, usdtDenom)
+	require.ErrorContains(t, err, "invalid rate")
+
+	_, err = k.ConversionRate(ctx, atomDenom)
+	require.ErrorContains(t, err, "unknown denom")
+
+	_, err = k.ConversionRate(ctx, "orai")
+	require.ErrorContains(t, err, "not a fee token")
+
+	_, err = k.ConvertFees(ctx, sdk.NewCoins(sdk.NewInt64Coin(atomDenom, 1)))
+	require.Error(t, err)
+}
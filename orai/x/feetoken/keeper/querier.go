@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/oraichain/orai/x/feetoken/types"
+)
+
+var _ types.QueryServer = &Querier{}
+
+type Querier struct {
+	keeper Keeper
+}
+
+func NewQuerier(k Keeper) Querier {
+	return Querier{
+		keeper: k,
+	}
+}
+
+// ConversionRate returns the current rate of a whitelisted fee token.
+func (q Querier) ConversionRate(stdCtx context.Context, req *types.QueryConversionRateRequest) (*types.QueryConversionRateResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	rate, err := q.keeper.ConversionRate(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryConversionRateResponse{
+		Rate: rate,
+	}, nil
+}
+
+// Params returns the feetoken parameters.
+func (q Querier) Params(stdCtx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+
+	return &types.QueryParamsResponse{
+		Params: q.keeper.GetParams(ctx),
+	}, nil
+}
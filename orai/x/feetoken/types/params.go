@@ -0,0 +1,131 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	"gopkg.in/yaml.v2"
+)
+
+// DefaultBaseDenom is the native fee denom.
+const DefaultBaseDenom = "orai"
+
+// Parameter store keys
+var (
+	KeyBaseDenom = []byte("BaseDenom")
+	KeyFeeTokens = []byte("FeeTokens")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable the param key table for the feetoken module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(baseDenom string, feeTokens []FeeToken) Params {
+	return Params{
+		BaseDenom: baseDenom,
+		FeeTokens: feeTokens,
+	}
+}
+
+// DefaultParams returns default parameters, no fee token is whitelisted.
+func DefaultParams() Params {
+	return Params{
+		BaseDenom: DefaultBaseDenom,
+		FeeTokens: []FeeToken{},
+	}
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyBaseDenom, &p.BaseDenom, validateBaseDenom),
+		paramtypes.NewParamSetPair(KeyFeeTokens, &p.FeeTokens, validateFeeTokens),
+	}
+}
+
+// Validate performs basic validation.
+func (p Params) Validate() error {
+	if err := validateBaseDenom(p.BaseDenom); err != nil {
+		return err
+	}
+	return validateFeeTokens(p.FeeTokens)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// FeeToken returns the whitelisted fee token with the given denom.
+func (p Params) FeeToken(denom string) (FeeToken, bool) {
+	for _, ft := range p.FeeTokens {
+		if ft.Denom == denom {
+			return ft, true
+		}
+	}
+	return FeeToken{}, false
+}
+
+// Validate performs basic validation of a fee token.
+func (ft FeeToken) Validate() error {
+	if !strings.HasPrefix(ft.Denom, ibctransfertypes.DenomPrefix+"/") {
+		return fmt.Errorf("fee token must be an IBC denom: %s", ft.Denom)
+	}
+	if err := ibctransfertypes.ValidateIBCDenom(ft.Denom); err != nil {
+		return err
+	}
+
+	if ft.Rate.IsNil() || ft.Rate.IsNegative() {
+		return fmt.Errorf("invalid rate for %s: %s", ft.Denom, ft.Rate)
+	}
+
+	if ft.Contract != "" {
+		if _, err := sdk.AccAddressFromBech32(ft.Contract); err != nil {
+			return fmt.Errorf("invalid rate contract for %s: %w", ft.Denom, err)
+		}
+		return nil
+	}
+
+	if !ft.Rate.IsPositive() {
+		return fmt.Errorf("fee token %s needs a positive rate or a rate contract", ft.Denom)
+	}
+
+	return nil
+}
+
+func validateBaseDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return sdk.ValidateDenom(v)
+}
+
+func validateFeeTokens(i interface{}) error {
+	v, ok := i.([]FeeToken)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, ft := range v {
+		if err := ft.Validate(); err != nil {
+			return err
+		}
+		if seen[ft.Denom] {
+			return fmt.Errorf("duplicate fee token: %s", ft.Denom)
+		}
+		seen[ft.Denom] = true
+	}
+
+	return nil
+}
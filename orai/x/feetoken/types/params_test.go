@@ -0,0 +1,71 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+const usdtDenom = "ibc/C4CFF46FD6DE35CA4CF4CE031E643C8FDC9BA4B99AE598E9B0ED98FE3A2319F9"
+
+func TestParamsValidate(t *testing.T) {
+	contract := sdk.AccAddress("contract____________").String()
+
+	cases := map[string]struct {
+		params Params
+		expErr bool
+	}{
+		"default": {
+			params: DefaultParams(),
+		},
+		"fixed rate": {
+			params: NewParams("orai", []FeeToken{{Denom: usdtDenom, Rate: sdk.NewDecWithPrec(5, 1)}}),
+		},
+		"contract rate": {
+			params: NewParams("orai", []FeeToken{{Denom: usdtDenom, Rate: sdk.ZeroDec(), Contract: contract}}),
+		},
+		"invalid base denom": {
+			params: NewParams("", nil),
+			expErr: true,
+		},
+		"not an ibc denom": {
+			params: NewParams("orai", []FeeToken{{Denom: "uatom", Rate: sdk.OneDec()}}),
+			expErr: true,
+		},
+		"invalid ibc hash": {
+			params: NewParams("orai", []FeeToken{{Denom: "ibc/xyz", Rate: sdk.OneDec()}}),
+			expErr: true,
+		},
+		"zero rate": {
+			params: NewParams("orai", []FeeToken{{Denom: usdtDenom, Rate: sdk.ZeroDec()}}),
+			expErr: true,
+		},
+		"nil rate without contract": {
+			params: NewParams("orai", []FeeToken{{Denom: usdtDenom}}),
+			expErr: true,
+		},
+		"invalid contract": {
+			params: NewParams("orai", []FeeToken{{Denom: usdtDenom, Rate: sdk.ZeroDec(), Contract: "orai1invalid"}}),
+			expErr: true,
+		},
+		"duplicate fee token": {
+			params: NewParams("orai", []FeeToken{
+				{Denom: usdtDenom, Rate: sdk.OneDec()},
+				{Denom: usdtDenom, Rate: sdk.ZeroDec(), Contract: contract},
+			}),
+			expErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/oraichain/orai/x/globalfee/types"
+)
+
+// GlobalFeeKeeper defines the expected globalfee keeper.
+type GlobalFeeKeeper interface {
+	GetParams(ctx sdk.Context) types.Params
+}
+
+// FeeConverter converts fees paid in other denoms, e.g. whitelisted IBC
+// tokens, into the denoms of the minimum gas prices. It only affects the
+// check, the fee coins themselves are deducted unchanged.
+type FeeConverter interface {
+	ConvertFees(ctx sdk.Context, fees sdk.Coins) (sdk.Coins, error)
+}
+
+// FeeDecorator checks that a tx pays at least the minimum gas prices set by
+// governance. Unlike the node-local --minimum-gas-prices, the check runs in
+// DeliverTx as well, so the floor is part of consensus. During CheckTx the
+// local minimum gas prices still apply on top of the global ones.
+//
+// When a fee converter is set, fees paid in convertible denoms count towards
+// the floor at their converted value.
+type FeeDecorator struct {
+	globalFeeKeeper GlobalFeeKeeper
+	feeConverter    FeeConverter
+}
+
+func NewFeeDecorator(globalFeeKeeper GlobalFeeKeeper, feeConverter FeeConverter) FeeDecorator {
+	return FeeDecorator{
+		globalFeeKeeper: globalFeeKeeper,
+		feeConverter:    feeConverter,
+	}
+}
+
+func (fd FeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	// gentxs are delivered at height 0 without fees
+	if ctx.BlockHeight() == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	// simulated txs usually carry no fees and are not checked, but they read
+	// the same state as delivered txs so that both use the same gas
+	params := fd.globalFeeKeeper.GetParams(ctx)
+	if simulate {
+		if fd.feeConverter != nil {
+			_, _ = fd.feeConverter.ConvertFees(ctx, feeTx.GetFee())
+		}
+		return next(ctx, tx, simulate)
+	}
+
+	gas := feeTx.GetGas()
+	if IsBypassMinFeeTx(params, feeTx.GetMsgs(), gas) {
+		return next(ctx, tx, simulate)
+	}
+
+	requiredFees := RequiredFees(MinGasPrices(ctx, params), gas)
+	if requiredFees.IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	feeCoins := feeTx.GetFee()
+	paidFees := feeCoins
+	if fd.feeConverter != nil {
+		if paidFees, err = fd.feeConverter.ConvertFees(ctx, feeCoins); err != nil {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrInsufficientFee, err.Error())
+		}
+	}
+
+	if !paidFees.IsAnyGTE(requiredFees) {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// IsBypassMinFeeTx returns true if the tx only contains msgs that are allowed
+// to skip the fee floor and stays under the bypass gas limit.
+func IsBypassMinFeeTx(params types.Params, msgs []sdk.Msg, gas uint64) bool {
+	if len(msgs) == 0 || gas > params.MaxTotalBypassMinFeeMsgGasUsage {
+		return false
+	}
+
+	for _, msg := range msgs {
+		if !containsString(params.BypassMinFeeMsgTypes, sdk.MsgTypeURL(msg)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// MinGasPrices returns the gas prices a tx must pay in the given context. In
+// DeliverTx they are the global prices. In CheckTx the node-local prices are
+// used when no global price is set, otherwise the higher of the global and
+// local price is taken for every global denom.
+func MinGasPrices(ctx sdk.Context, params types.Params) sdk.DecCoins {
+	globalPrices := params.MinimumGasPrices
+	if !ctx.IsCheckTx() {
+		return globalPrices
+	}
+
+	localPrices := ctx.MinGasPrices()
+	if len(globalPrices) == 0 {
+		return localPrices
+	}
+
+	prices := make(sdk.DecCoins, len(globalPrices))
+	for i, gp := range globalPrices {
+		prices[i] = gp
+		if local := localPrices.AmountOf(gp.Denom); local.GT(gp.Amount) {
+			prices[i] = sdk.NewDecCoinFromDec(gp.Denom, local)
+		}
+	}
+
+	return prices
+}
+
+// RequiredFees multiplies each gas price by the gas limit, where
+// fee = ceil(minGasPrice * gasLimit). Zero fees are dropped.
+func RequiredFees(prices sdk.DecCoins, gas uint64) sdk.Coins {
+	glDec := sdk.NewDec(int64(gas))
+
+	requiredFees := make(sdk.Coins, 0, len(prices))
+	for _, gp := range prices {
+		fee := gp.Amount.Mul(glDec).Ceil().RoundInt()
+		if fee.IsPositive() {
+			requiredFees = append(requiredFees, sdk.NewCoin(gp.Denom, fee))
+		}
+	}
+
+	return requiredFees.Sort()
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,171 @@
+package ante_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibcchanneltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/oraichain/orai/x/globalfee/ante"
+	"github.com/oraichain/orai/x/globalfee/types"
+)
+
+type mockKeeper struct {
+	params types.Params
+}
+
+func (k mockKeeper) GetParams(_ sdk.Context) types.Params {
+	return k.params
+}
+
+// mockConverter values every coin of denom at rate orai per unit.
+type mockConverter struct {
+	denom string
+	rate  sdk.Dec
+}
+
+func (c mockConverter) ConvertFees(_ sdk.Context, fees sdk.Coins) (sdk.Coins, error) {
+	converted := sdk.NewCoins()
+	for _, fee := range fees {
+		if fee.Denom == c.denom {
+			fee = sdk.NewCoin("orai", c.rate.MulInt(fee.Amount).TruncateInt())
+		}
+		converted = converted.Add(fee)
+	}
+	return converted, nil
+}
+
+type mockFeeTx struct {
+	msgs []sdk.Msg
+	fee  sdk.Coins
+	gas  uint64
+}
+
+func (tx mockFeeTx) GetMsgs() []sdk.Msg         { return tx.msgs }
+func (tx mockFeeTx) ValidateBasic() error       { return nil }
+func (tx mockFeeTx) GetGas() uint64             { return tx.gas }
+func (tx mockFeeTx) GetFee() sdk.Coins          { return tx.fee }
+func (tx mockFeeTx) FeePayer() sdk.AccAddress   { return nil }
+func (tx mockFeeTx) FeeGranter() sdk.AccAddress { return nil }
+
+func TestFeeDecorator(t *testing.T) {
+	addr := sdk.AccAddress("addr1_______________")
+	send := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("orai", 1)))
+	recv := &ibcchanneltypes.MsgRecvPacket{Signer: addr.String()}
+
+	globalPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("orai", sdk.NewDecWithPrec(1, 3)))
+	localPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("orai", sdk.NewDecWithPrec(2, 3)))
+
+	cases := map[string]struct {
+		globalPrices sdk.DecCoins
+		localPrices  sdk.DecCoins
+		converter    ante.FeeConverter
+		checkTx      bool
+		simulate     bool
+		height       int64
+		tx           mockFeeTx
+		expErr       bool
+	}{
+		"no floor": {
+			tx: mockFeeTx{msgs: []sdk.Msg{send}, gas: 100_000},
+		},
+		"global floor met in deliver tx": {
+			globalPrices: globalPrices,
+			tx:           mockFeeTx{msgs: []sdk.Msg{send}, gas: 100_000, fee: sdk.NewCoins(sdk.NewInt64Coin("orai", 100))},
+		},
+		"global floor not met in deliver tx": {
+			globalPrices: globalPrices,
+			tx:           mockFeeTx{msgs: []sdk.Msg{send}, gas: 100_000, fee: sdk.NewCoins(sdk.NewInt64Coin("orai", 99))},
+			expErr:       true,
+		},
+		"wrong denom": {
+			globalPrices: globalPrices,
+			tx:           mockFeeTx{msgs: []sdk.Msg{send}, gas: 100_000, fee: sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))},
+			expErr:       true,
+		},
+		"local floor ignored in deliver tx": {
+			globalPrices: globalPrices,
+			localPrices:  localPrices,
+			tx:           mockFeeTx{msgs: []sdk.Msg{send}, gas: 100_000, fee: sdk.NewCoins(sdk.NewInt64Coin("orai", 100))},
+		},
+		"higher local floor applies in check tx": {
+			globalPrices: globalPrices,
+			localPrices:  localPrices,
+			checkTx:      true,
+			tx:           mockFeeTx{msgs: []sdk.Msg{send}, gas: 100_000, fee: sdk.NewCoins(sdk.NewInt64Coin("orai", 100))},
+			expErr:       true,
+		},
+		"local floor alone applies in check tx": {
+			localPrices: localPrices,
+			checkTx:     true,
+			tx:          mockFeeTx{msgs: []sdk.Msg{send}, gas: 100_000, fee: sdk.NewCoins(sdk.NewInt64Coin("orai", 100))},
+			expErr:      true,
+		},
+		"bypass relayer msgs": {
+			globalPrices: globalPrices,
+			tx:           mockFeeTx{msgs: []sdk.Msg{recv, recv}, gas: 100_000},
+		},
+		"bypass relayer msgs over gas limit": {
+			globalPrices: globalPrices,
+			tx:           mockFeeTx{msgs: []sdk.Msg{recv}, gas: types.DefaultMaxTotalBypassMinFeeMsgGasUsage + 1},
+			expErr:       true,
+		},
+		"no bypass when mixed with other msgs": {
+			globalPrices: globalPrices,
+			tx:           mockFeeTx{msgs: []sdk.Msg{recv, send}, gas: 100_000},
+			expErr:       true,
+		},
+		"converted fee token meets floor": {
+			globalPrices: globalPrices,
+			converter:    mockConverter{denom: "ibc/usdt", rate: sdk.NewDec(2)},
+			tx:           mockFeeTx{msgs: []sdk.Msg{send}, gas: 100_000, fee: sdk.NewCoins(sdk.NewInt64Coin("ibc/usdt", 50))},
+		},
+		"converted fee token adds to base denom": {
+			globalPrices: globalPrices,
+			converter:    mockConverter{denom: "ibc/usdt", rate: sdk.NewDec(2)},
+			tx:           mockFeeTx{msgs: []sdk.Msg{send}, gas: 100_000, fee: sdk.NewCoins(sdk.NewInt64Coin("ibc/usdt", 25), sdk.NewInt64Coin("orai", 50))},
+		},
+		"converted fee token below floor": {
+			globalPrices: globalPrices,
+			converter:    mockConverter{denom: "ibc/usdt", rate: sdk.NewDec(2)},
+			tx:           mockFeeTx{msgs: []sdk.Msg{send}, gas: 100_000, fee: sdk.NewCoins(sdk.NewInt64Coin("ibc/usdt", 49))},
+			expErr:       true,
+		},
+		"simulate": {
+			globalPrices: globalPrices,
+			simulate:     true,
+			tx:           mockFeeTx{msgs: []sdk.Msg{send}, gas: 100_000},
+		},
+		"genesis tx": {
+			globalPrices: globalPrices,
+			height:       -1,
+			tx:           mockFeeTx{msgs: []sdk.Msg{send}, gas: 100_000},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.MinimumGasPrices = tc.globalPrices
+
+			height := int64(10)
+			if tc.height < 0 {
+				height = 0
+			}
+			ctx := sdk.NewContext(nil, tmproto.Header{Height: height}, tc.checkTx, nil).WithMinGasPrices(tc.localPrices)
+
+			decorator := ante.NewFeeDecorator(mockKeeper{params: params}, tc.converter)
+			_, err := decorator.AnteHandle(ctx, tc.tx, tc.simulate, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+				return ctx, nil
+			})
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
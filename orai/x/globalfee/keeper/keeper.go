@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/oraichain/orai/x/globalfee/types"
+)
+
+// Keeper of the globalfee store
+type Keeper struct {
+	paramSpace paramtypes.Subspace
+}
+
+func NewKeeper(paramSpace paramtypes.Subspace) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		paramSpace: paramSpace,
+	}
+}
+
+// GetParams returns the current x/globalfee module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (p types.Params) {
+	k.paramSpace.GetParamSet(ctx, &p)
+	return p
+}
+
+// SetParams sets the x/globalfee module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, p types.Params) {
+	k.paramSpace.SetParamSet(ctx, &p)
+}
+
+// GetMinimumGasPrices returns the consensus-enforced minimum gas prices.
+func (k Keeper) GetMinimumGasPrices(ctx sdk.Context) (prices sdk.DecCoins) {
+	k.paramSpace.Get(ctx, types.KeyMinimumGasPrices, &prices)
+	return prices
+}
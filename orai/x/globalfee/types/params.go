@@ -0,0 +1,127 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	ibcclienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	ibcchanneltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"gopkg.in/yaml.v2"
+)
+
+// DefaultMaxTotalBypassMinFeeMsgGasUsage is the gas a tx made only of bypass
+// msgs can use before it has to pay fees.
+const DefaultMaxTotalBypassMinFeeMsgGasUsage uint64 = 1_000_000
+
+// Parameter store keys
+var (
+	KeyMinimumGasPrices                = []byte("MinimumGasPrices")
+	KeyBypassMinFeeMsgTypes            = []byte("BypassMinFeeMsgTypes")
+	KeyMaxTotalBypassMinFeeMsgGasUsage = []byte("MaxTotalBypassMinFeeMsgGasUsage")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable the param key table for the globalfee module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(minimumGasPrices sdk.DecCoins, bypassMsgTypes []string, maxBypassGas uint64) Params {
+	return Params{
+		MinimumGasPrices:                minimumGasPrices,
+		BypassMinFeeMsgTypes:            bypassMsgTypes,
+		MaxTotalBypassMinFeeMsgGasUsage: maxBypassGas,
+	}
+}
+
+// DefaultParams returns default parameters. No global floor is set so that
+// only the node-local minimum gas prices apply until governance sets one, and
+// relayer msgs are free so that IBC keeps flowing.
+func DefaultParams() Params {
+	return Params{
+		MinimumGasPrices: sdk.DecCoins{},
+		BypassMinFeeMsgTypes: []string{
+			sdk.MsgTypeURL(&ibcchanneltypes.MsgRecvPacket{}),
+			sdk.MsgTypeURL(&ibcchanneltypes.MsgAcknowledgement{}),
+			sdk.MsgTypeURL(&ibcchanneltypes.MsgTimeout{}),
+			sdk.MsgTypeURL(&ibcchanneltypes.MsgTimeoutOnClose{}),
+			sdk.MsgTypeURL(&ibcclienttypes.MsgUpdateClient{}),
+		},
+		MaxTotalBypassMinFeeMsgGasUsage: DefaultMaxTotalBypassMinFeeMsgGasUsage,
+	}
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMinimumGasPrices, &p.MinimumGasPrices, validateMinimumGasPrices),
+		paramtypes.NewParamSetPair(KeyBypassMinFeeMsgTypes, &p.BypassMinFeeMsgTypes, validateBypassMinFeeMsgTypes),
+		paramtypes.NewParamSetPair(KeyMaxTotalBypassMinFeeMsgGasUsage, &p.MaxTotalBypassMinFeeMsgGasUsage, validateMaxTotalBypassMinFeeMsgGasUsage),
+	}
+}
+
+// Validate performs basic validation.
+func (p Params) Validate() error {
+	if err := validateMinimumGasPrices(p.MinimumGasPrices); err != nil {
+		return err
+	}
+	if err := validateBypassMinFeeMsgTypes(p.BypassMinFeeMsgTypes); err != nil {
+		return err
+	}
+	return validateMaxTotalBypassMinFeeMsgGasUsage(p.MaxTotalBypassMinFeeMsgGasUsage)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+func validateMinimumGasPrices(i interface{}) error {
+	v, ok := i.(sdk.DecCoins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// sorted, no duplicates, no negative amounts. Zero amounts are allowed so
+	// that a denom can be accepted without a floor.
+	for i, coin := range v {
+		if err := sdk.ValidateDenom(coin.Denom); err != nil {
+			return err
+		}
+		if coin.Amount.IsNil() || coin.IsNegative() {
+			return fmt.Errorf("invalid minimum gas price: %s", coin)
+		}
+		if i > 0 && v[i-1].Denom >= coin.Denom {
+			return fmt.Errorf("minimum gas prices must be sorted and without duplicates: %s", v)
+		}
+	}
+
+	return nil
+}
+
+func validateBypassMinFeeMsgTypes(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for _, msgType := range v {
+		if len(msgType) == 0 || msgType[0] != '/' {
+			return fmt.Errorf("invalid msg type url: %q", msgType)
+		}
+	}
+
+	return nil
+}
+
+func validateMaxTotalBypassMinFeeMsgGasUsage(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
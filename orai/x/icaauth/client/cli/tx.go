@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/oraichain/orai/x/icaauth/types"
+)
+
+const (
+	FlagVersion = "version"
+	FlagTimeout = "timeout"
+	FlagMemo    = "memo"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Interchain accounts transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(
+		GetCmdRegisterAccount(),
+		GetCmdSubmitTx(),
+	)
+	return txCmd
+}
+
+func GetCmdRegisterAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "register [connection-id]",
+		Short:   "Register an interchain account of the sender on the host chain of the connection",
+		Example: "oraid tx icaauth register connection-0 --from owner",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			version, err := cmd.Flags().GetString(FlagVersion)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterAccount(clientCtx.GetFromAddress(), args[0], version)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(FlagVersion, "", "Version of the channel, negotiated with the host chain when empty")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdSubmitTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-tx [connection-id] [path/to/msgs.json]",
+		Short: "Execute msgs with the interchain account of the sender on the host chain of the connection",
+		Long: `Execute msgs with the interchain account of the sender on the host chain of the connection.
+The file holds a JSON msg or an array of JSON msgs, typed with their "@type" url.`,
+		Example: "oraid tx icaauth submit-tx connection-0 msgs.json --timeout 10m --from owner",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contents, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			msgs, err := parseMsgs(clientCtx, contents)
+			if err != nil {
+				return err
+			}
+
+			timeout, err := cmd.Flags().GetDuration(FlagTimeout)
+			if err != nil {
+				return err
+			}
+			memo, err := cmd.Flags().GetString(FlagMemo)
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgSubmitTx(clientCtx.GetFromAddress(), args[0], msgs, memo, timeout)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().Duration(FlagTimeout, time.Hour, "Time after the current block time at which the packet times out")
+	cmd.Flags().String(FlagMemo, "", "Memo of the interchain account packet")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseMsgs decodes a JSON msg or an array of JSON msgs.
+func parseMsgs(clientCtx client.Context, contents []byte) ([]sdk.Msg, error) {
+	var raws []json.RawMessage
+	if err := json.Unmarshal(contents, &raws); err != nil {
+		raws = []json.RawMessage{contents}
+	}
+
+	msgs := make([]sdk.Msg, len(raws))
+	for i, raw := range raws {
+		if err := clientCtx.Codec.UnmarshalInterfaceJSON(raw, &msgs[i]); err != nil {
+			return nil, fmt.Errorf("invalid msg %d: %w", i, err)
+		}
+	}
+	return msgs, nil
+}
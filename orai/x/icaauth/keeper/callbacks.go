@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+
+	"github.com/oraichain/orai/x/icaauth/types"
+)
+
+// OnAcknowledgementPacket emits the result of a tx executed by an interchain
+// account and passes it to the owner if it is a contract.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement) {
+	owner := packetOwner(packet)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAcknowledgement,
+			sdk.NewAttribute(types.AttributeKeyOwner, owner),
+			sdk.NewAttribute(types.AttributeKeyChannelID, packet.SourceChannel),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(ack.Success())),
+			sdk.NewAttribute(types.AttributeKeyError, ack.GetError()),
+		),
+	)
+
+	k.callbackContract(ctx, owner, packet, types.SudoMsg{
+		ICAAcknowledgement: &types.ICAAcknowledgement{
+			ChannelID: packet.SourceChannel,
+			Sequence:  packet.Sequence,
+			Result:    ack.GetResult(),
+			Error:     ack.GetError(),
+		},
+	})
+}
+
+// OnTimeoutPacket emits the timeout of a tx sent to an interchain account and
+// passes it to the owner if it is a contract.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) {
+	owner := packetOwner(packet)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTimeout,
+			sdk.NewAttribute(types.AttributeKeyOwner, owner),
+			sdk.NewAttribute(types.AttributeKeyChannelID, packet.SourceChannel),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+		),
+	)
+
+	k.callbackContract(ctx, owner, packet, types.SudoMsg{
+		ICATimeout: &types.ICATimeout{
+			ChannelID: packet.SourceChannel,
+			Sequence:  packet.Sequence,
+		},
+	})
+}
+
+// callbackContract calls the contract owning the interchain account with the
+// sudo msg, within the callback gas limit. The packet is acknowledged whatever
+// the contract does: its state changes are discarded and the error emitted
+// when it fails, so that a contract cannot block the channel.
+func (k Keeper) callbackContract(ctx sdk.Context, owner string, packet channeltypes.Packet, msg types.SudoMsg) {
+	contract, err := sdk.AccAddressFromBech32(owner)
+	if err != nil || !k.contractKeeper.HasContractInfo(ctx, contract) {
+		return
+	}
+
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	if err := k.sudo(ctx, contract, bz); err != nil {
+		k.Logger(ctx).Debug("contract callback failed", "contract", owner, "channel_id", packet.SourceChannel, "sequence", packet.Sequence, "error", err)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCallbackFailed,
+				sdk.NewAttribute(types.AttributeKeyOwner, owner),
+				sdk.NewAttribute(types.AttributeKeyChannelID, packet.SourceChannel),
+				sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			),
+		)
+	}
+}
+
+func (k Keeper) sudo(ctx sdk.Context, contract sdk.AccAddress, msg []byte) (err error) {
+	gasLimit := k.GetParams(ctx).CallbackGasLimit
+	gasMeter := sdk.NewGasMeter(gasLimit)
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter)
+
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "callback exceeds gas limit %d", gasLimit)
+		}
+		ctx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), "icaauth callback")
+	}()
+
+	if _, err := k.contractKeeper.Sudo(cacheCtx, contract, msg); err != nil {
+		return err
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
+
+// packetOwner returns the owner of the controller port the packet was sent from.
+func packetOwner(packet channeltypes.Packet) string {
+	return strings.TrimPrefix(packet.SourcePort, icatypes.PortPrefix)
+}
@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/oraichain/orai/x/icaauth/types"
+)
+
+// Keeper of the icaauth module. It authenticates the owners of interchain
+// accounts and owns the capabilities of their channels.
+type Keeper struct {
+	cdc        codec.Codec
+	paramSpace paramtypes.Subspace
+
+	icaControllerKeeper types.ICAControllerKeeper
+	scopedKeeper        types.ScopedKeeper
+	contractKeeper      types.ContractKeeper
+}
+
+func NewKeeper(cdc codec.Codec, paramSpace paramtypes.Subspace, icaControllerKeeper types.ICAControllerKeeper, scopedKeeper types.ScopedKeeper, contractKeeper types.ContractKeeper) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:                 cdc,
+		paramSpace:          paramSpace,
+		icaControllerKeeper: icaControllerKeeper,
+		scopedKeeper:        scopedKeeper,
+		contractKeeper:      contractKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns the current x/icaauth module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (p types.Params) {
+	k.paramSpace.GetParamSet(ctx, &p)
+	return p
+}
+
+// SetParams sets the x/icaauth module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, p types.Params) {
+	k.paramSpace.SetParamSet(ctx, &p)
+}
+
+// ClaimCapability claims the channel capability passed via the
+// OnChanOpenInit callback.
+func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error {
+	return k.scopedKeeper.ClaimCapability(ctx, cap, name)
+}
+
+// RegisterAccount opens the channel of the interchain account of the owner
+// on the host chain of the connection. It returns the controller port of the
+// owner.
+func (k Keeper) RegisterAccount(ctx sdk.Context, owner, connectionID, version string) (string, error) {
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return "", err
+	}
+
+	if err := k.icaControllerKeeper.RegisterInterchainAccount(ctx, connectionID, owner, version); err != nil {
+		return "", err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterAccount,
+			sdk.NewAttribute(types.AttributeKeyOwner, owner),
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+		),
+	)
+
+	return portID, nil
+}
+
+// SubmitTx sends the msgs to the interchain account of the owner on the host
+// chain of the connection. It returns the channel and sequence of the packet.
+// The msgs are passed packed, they may be msgs of the host chain unknown to
+// this one.
+func (k Keeper) SubmitTx(ctx sdk.Context, owner, connectionID string, msgs []*codectypes.Any, memo string, timeoutTimestamp uint64) (string, uint64, error) {
+	if maxMsgs := k.GetParams(ctx).MaxMsgs; maxMsgs > 0 && uint64(len(msgs)) > maxMsgs {
+		return "", 0, sdkerrors.Wrapf(types.ErrTooManyMsgs, "%d msgs, max %d", len(msgs), maxMsgs)
+	}
+
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return "", 0, err
+	}
+
+	channelID, found := k.icaControllerKeeper.GetActiveChannelID(ctx, connectionID, portID)
+	if !found {
+		return "", 0, sdkerrors.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel for port %s", portID)
+	}
+
+	chanCap, found := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(portID, channelID))
+	if !found {
+		return "", 0, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	data, err := k.cdc.Marshal(&icatypes.CosmosTx{Messages: msgs})
+	if err != nil {
+		return "", 0, err
+	}
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: memo,
+	}
+	sequence, err := k.icaControllerKeeper.SendTx(ctx, chanCap, connectionID, portID, packetData, timeoutTimestamp)
+	if err != nil {
+		return "", 0, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubmitTx,
+			sdk.NewAttribute(types.AttributeKeyOwner, owner),
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprint(sequence)),
+		),
+	)
+
+	return channelID, sequence, nil
+}
+
+// GetInterchainAccountAddress returns the address of the interchain account
+// of the owner on the host chain of the connection.
+func (k Keeper) GetInterchainAccountAddress(ctx sdk.Context, owner, connectionID string) (string, error) {
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return "", err
+	}
+
+	address, found := k.icaControllerKeeper.GetInterchainAccountAddress(ctx, connectionID, portID)
+	if !found {
+		return "", sdkerrors.Wrapf(types.ErrAccountNotFound, "no account found for port %s on %s", portID, connectionID)
+	}
+	return address, nil
+}
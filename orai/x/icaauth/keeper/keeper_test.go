@@ -0,0 +1,362 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/oraichain/orai/x/icaauth/keeper"
+	"github.com/oraichain/orai/x/icaauth/types"
+)
+
+const connectionID = "connection-0"
+
+type mockControllerKeeper struct {
+	// active channels by port
+	channels  map[string]string
+	addresses map[string]string
+
+	registered []string
+	sent       []icatypes.InterchainAccountPacketData
+	timeouts   []uint64
+}
+
+func (k *mockControllerKeeper) RegisterInterchainAccount(_ sdk.Context, _, owner, _ string) error {
+	k.registered = append(k.registered, owner)
+	return nil
+}
+
+func (k *mockControllerKeeper) GetActiveChannelID(_ sdk.Context, _, portID string) (string, bool) {
+	channelID, found := k.channels[portID]
+	return channelID, found
+}
+
+func (k *mockControllerKeeper) GetInterchainAccountAddress(_ sdk.Context, _, portID string) (string, bool) {
+	address, found := k.addresses[portID]
+	return address, found
+}
+
+func (k *mockControllerKeeper) SendTx(_ sdk.Context, _ *capabilitytypes.Capability, _, _ string, data icatypes.InterchainAccountPacketData, timeoutTimestamp uint64) (uint64, error) {
+	k.sent = append(k.sent, data)
+	k.timeouts = append(k.timeouts, timeoutTimestamp)
+	return uint64(len(k.sent)), nil
+}
+
+type mockContractKeeper struct {
+	contracts map[string]bool
+	// sudo is called with the contract store, it fails when it returns an error
+	sudo  func(ctx sdk.Context, msg types.SudoMsg) error
+	calls []types.SudoMsg
+}
+
+func (k *mockContractKeeper) HasContractInfo(_ sdk.Context, contractAddress sdk.AccAddress) bool {
+	return k.contracts[contractAddress.String()]
+}
+
+func (k *mockContractKeeper) Sudo(ctx sdk.Context, _ sdk.AccAddress, msg []byte) ([]byte, error) {
+	var sudoMsg types.SudoMsg
+	if err := json.Unmarshal(msg, &sudoMsg); err != nil {
+		return nil, err
+	}
+	k.calls = append(k.calls, sudoMsg)
+	return nil, k.sudo(ctx, sudoMsg)
+}
+
+type testFixture struct {
+	ctx              sdk.Context
+	cdc              codec.Codec
+	keeper           keeper.Keeper
+	controller       *mockControllerKeeper
+	contracts        *mockContractKeeper
+	capabilityKeeper *capabilitykeeper.Keeper
+	scopedKeeper     capabilitykeeper.ScopedKeeper
+	legacyKeeper     capabilitykeeper.ScopedKeeper
+}
+
+// paramsStoreKey is mounted by setupKeeper, the store written by the test contracts
+var paramsStoreKey = sdk.NewKVStoreKey(paramstypes.StoreKey)
+
+func setupKeeper(t *testing.T) testFixture {
+	paramsKey := paramsStoreKey
+	paramsTKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+	capKey := sdk.NewKVStoreKey(capabilitytypes.StoreKey)
+	capMemKey := storetypes.NewMemoryStoreKey(capabilitytypes.MemStoreKey)
+
+	ms := store.NewCommitMultiStore(dbm.NewMemDB())
+	ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, nil)
+	ms.MountStoreWithDB(capKey, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(capMemKey, sdk.StoreTypeMemory, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	registry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	capabilityKeeper := capabilitykeeper.NewKeeper(cdc, capKey, capMemKey)
+	scopedKeeper := capabilityKeeper.ScopeToModule(types.ModuleName)
+	legacyKeeper := capabilityKeeper.ScopeToModule("intertx")
+	capabilityKeeper.Seal()
+
+	paramsKeeper := paramskeeper.NewKeeper(cdc, codec.NewLegacyAmino(), paramsKey, paramsTKey)
+	controller := &mockControllerKeeper{channels: map[string]string{}, addresses: map[string]string{}}
+	contracts := &mockContractKeeper{contracts: map[string]bool{}}
+	k := keeper.NewKeeper(cdc, paramsKeeper.Subspace(types.ModuleName), controller, scopedKeeper, contracts)
+
+	ctx := sdk.NewContext(ms, tmproto.Header{Time: time.Unix(1700000000, 0)}, false, log.NewNopLogger())
+	k.SetParams(ctx, types.DefaultParams())
+
+	return testFixture{
+		ctx:              ctx,
+		cdc:              cdc,
+		keeper:           k,
+		controller:       controller,
+		contracts:        contracts,
+		capabilityKeeper: capabilityKeeper,
+		scopedKeeper:     scopedKeeper,
+		legacyKeeper:     legacyKeeper,
+	}
+}
+
+func TestRegisterAccount(t *testing.T) {
+	f := setupKeeper(t)
+	owner := sdk.AccAddress("owner_______________")
+
+	res, err := keeper.NewMsgServerImpl(f.keeper).RegisterAccount(sdk.WrapSDKContext(f.ctx), types.NewMsgRegisterAccount(owner, connectionID, ""))
+	require.NoError(t, err)
+	require.Equal(t, icatypes.PortPrefix+owner.String(), res.PortId)
+	require.Equal(t, []string{owner.String()}, f.controller.registered)
+
+	events := f.ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeRegisterAccount, events[0].Type)
+}
+
+func TestSubmitTx(t *testing.T) {
+	owner := sdk.AccAddress("owner_______________")
+	portID := icatypes.PortPrefix + owner.String()
+	send := &banktypes.MsgSend{FromAddress: "host1", ToAddress: "host2", Amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1))}
+
+	tests := []struct {
+		name    string
+		setup   func(f testFixture)
+		msgs    []sdk.Msg
+		wantErr error
+	}{
+		{
+			name: "success",
+			setup: func(f testFixture) {
+				f.controller.channels[portID] = "channel-0"
+				cap, err := f.scopedKeeper.NewCapability(f.ctx, host.ChannelCapabilityPath(portID, "channel-0"))
+				require.NoError(t, err)
+				require.NotNil(t, cap)
+			},
+			msgs: []sdk.Msg{send, send},
+		},
+		{
+			name: "too many msgs",
+			setup: func(f testFixture) {
+				f.keeper.SetParams(f.ctx, types.NewParams(1, types.DefaultCallbackGasLimit))
+			},
+			msgs:    []sdk.Msg{send, send},
+			wantErr: types.ErrTooManyMsgs,
+		},
+		{
+			name:    "no active channel",
+			setup:   func(f testFixture) {},
+			msgs:    []sdk.Msg{send},
+			wantErr: icatypes.ErrActiveChannelNotFound,
+		},
+		{
+			name: "channel capability not owned",
+			setup: func(f testFixture) {
+				f.controller.channels[portID] = "channel-0"
+			},
+			msgs:    []sdk.Msg{send},
+			wantErr: channeltypes.ErrChannelCapabilityNotFound,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := setupKeeper(t)
+			tc.setup(f)
+
+			msg, err := types.NewMsgSubmitTx(owner, connectionID, tc.msgs, "memo", time.Minute)
+			require.NoError(t, err)
+			res, err := keeper.NewMsgServerImpl(f.keeper).SubmitTx(sdk.WrapSDKContext(f.ctx), msg)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				require.Empty(t, f.controller.sent)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, &types.MsgSubmitTxResponse{ChannelId: "channel-0", Sequence: 1}, res)
+
+			require.Len(t, f.controller.sent, 1)
+			require.Equal(t, icatypes.EXECUTE_TX, f.controller.sent[0].Type)
+			require.Equal(t, "memo", f.controller.sent[0].Memo)
+			sent, err := icatypes.DeserializeCosmosTx(f.cdc, f.controller.sent[0].Data)
+			require.NoError(t, err)
+			require.Len(t, sent, 2)
+			require.Equal(t, uint64(f.ctx.BlockTime().Add(time.Minute).UnixNano()), f.controller.timeouts[0])
+		})
+	}
+}
+
+func TestInterchainAccount(t *testing.T) {
+	f := setupKeeper(t)
+	owner := sdk.AccAddress("owner_______________")
+	f.controller.addresses[icatypes.PortPrefix+owner.String()] = "host1account"
+
+	querier := keeper.NewQuerier(f.keeper)
+	res, err := querier.InterchainAccount(sdk.WrapSDKContext(f.ctx), &types.QueryInterchainAccountRequest{Owner: owner.String(), ConnectionId: connectionID})
+	require.NoError(t, err)
+	require.Equal(t, "host1account", res.InterchainAccountAddress)
+
+	_, err = querier.InterchainAccount(sdk.WrapSDKContext(f.ctx), &types.QueryInterchainAccountRequest{Owner: "other", ConnectionId: connectionID})
+	require.Error(t, err)
+}
+
+func TestMigrateChannelCapabilities(t *testing.T) {
+	f := setupKeeper(t)
+	openPort := icatypes.PortPrefix + "owner1"
+	closedPort := icatypes.PortPrefix + "owner2"
+
+	channels := []channeltypes.IdentifiedChannel{
+		{PortId: openPort, ChannelId: "channel-0", State: channeltypes.OPEN},
+		{PortId: closedPort, ChannelId: "channel-1", State: channeltypes.CLOSED},
+		{PortId: "transfer", ChannelId: "channel-2", State: channeltypes.OPEN},
+		// capability not owned by the legacy module
+		{PortId: openPort, ChannelId: "channel-3", State: channeltypes.OPEN},
+	}
+	for _, channel := range channels[:3] {
+		_, err := f.legacyKeeper.NewCapability(f.ctx, host.ChannelCapabilityPath(channel.PortId, channel.ChannelId))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, f.keeper.MigrateChannelCapabilities(f.ctx, f.legacyKeeper, channels))
+
+	owned := func(sk capabilitykeeper.ScopedKeeper, channel channeltypes.IdentifiedChannel) bool {
+		_, found := sk.GetCapability(f.ctx, host.ChannelCapabilityPath(channel.PortId, channel.ChannelId))
+		return found
+	}
+	require.True(t, owned(f.scopedKeeper, channels[0]))
+	require.False(t, owned(f.legacyKeeper, channels[0]))
+	require.True(t, owned(f.legacyKeeper, channels[1]))
+	require.False(t, owned(f.scopedKeeper, channels[1]))
+	require.True(t, owned(f.legacyKeeper, channels[2]))
+	require.False(t, owned(f.scopedKeeper, channels[3]))
+
+	// the new owner is persisted, not only claimed in memory
+	cap, found := f.scopedKeeper.GetCapability(f.ctx, host.ChannelCapabilityPath(openPort, "channel-0"))
+	require.True(t, found)
+	owners, found := f.capabilityKeeper.GetOwners(f.ctx, cap.GetIndex())
+	require.True(t, found)
+	require.Equal(t, []capabilitytypes.Owner{capabilitytypes.NewOwner(types.ModuleName, host.ChannelCapabilityPath(openPort, "channel-0"))}, owners.Owners)
+}
+
+func TestContractCallbacks(t *testing.T) {
+	contract := sdk.AccAddress("contract____________")
+	packet := channeltypes.Packet{SourcePort: icatypes.PortPrefix + contract.String(), SourceChannel: "channel-0", Sequence: 3}
+	stateKey := []byte("callback")
+	ack := channeltypes.NewErrorAcknowledgement(errors.New("host error"))
+
+	tests := []struct {
+		name       string
+		isContract bool
+		sudo       func(ctx sdk.Context, msg types.SudoMsg) error
+		wantCalled bool
+		wantState  bool
+		wantFailed bool
+	}{
+		{
+			name:       "success",
+			isContract: true,
+			sudo: func(ctx sdk.Context, _ types.SudoMsg) error {
+				ctx.KVStore(paramsStoreKey).Set(stateKey, []byte{1})
+				return nil
+			},
+			wantCalled: true,
+			wantState:  true,
+		},
+		{
+			name:       "contract error discards its changes",
+			isContract: true,
+			sudo: func(ctx sdk.Context, _ types.SudoMsg) error {
+				ctx.KVStore(paramsStoreKey).Set(stateKey, []byte{1})
+				return errors.New("failed")
+			},
+			wantCalled: true,
+			wantFailed: true,
+		},
+		{
+			name:       "out of gas",
+			isContract: true,
+			sudo: func(ctx sdk.Context, _ types.SudoMsg) error {
+				ctx.GasMeter().ConsumeGas(types.DefaultCallbackGasLimit+1, "test")
+				return nil
+			},
+			wantCalled: true,
+			wantFailed: true,
+		},
+		{
+			name:       "owner is not a contract",
+			isContract: false,
+			sudo:       func(sdk.Context, types.SudoMsg) error { return nil },
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := setupKeeper(t)
+			f.contracts.contracts[contract.String()] = tc.isContract
+			f.contracts.sudo = tc.sudo
+			ctx := f.ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+			f.keeper.OnAcknowledgementPacket(ctx, packet, ack)
+			f.keeper.OnTimeoutPacket(ctx, packet)
+
+			if !tc.wantCalled {
+				require.Empty(t, f.contracts.calls)
+				return
+			}
+			require.Equal(t, []types.SudoMsg{
+				{ICAAcknowledgement: &types.ICAAcknowledgement{ChannelID: "channel-0", Sequence: 3, Error: ack.GetError()}},
+				{ICATimeout: &types.ICATimeout{ChannelID: "channel-0", Sequence: 3}},
+			}, f.contracts.calls)
+			require.Equal(t, tc.wantState, ctx.KVStore(paramsStoreKey).Has(stateKey))
+
+			failed := 0
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypeCallbackFailed {
+					failed++
+				}
+			}
+			if tc.wantFailed {
+				require.Equal(t, 2, failed)
+			} else {
+				require.Zero(t, failed)
+			}
+		})
+	}
+}
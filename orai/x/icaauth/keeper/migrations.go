@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+
+	"github.com/oraichain/orai/x/icaauth/types"
+)
+
+// MigrateChannelCapabilities moves to this module the capabilities of the
+// interchain account channels owned by the authentication module it
+// replaces, so the accounts registered through it keep sending txs.
+// Channels that are closed or whose capability is not owned by the legacy
+// module are skipped.
+func (k Keeper) MigrateChannelCapabilities(ctx sdk.Context, legacyKeeper types.ScopedKeeper, channels []channeltypes.IdentifiedChannel) error {
+	for _, channel := range channels {
+		if !strings.HasPrefix(channel.PortId, icatypes.PortPrefix) || channel.State == channeltypes.CLOSED {
+			continue
+		}
+
+		name := host.ChannelCapabilityPath(channel.PortId, channel.ChannelId)
+		cap, found := legacyKeeper.GetCapability(ctx, name)
+		if !found {
+			continue
+		}
+		if err := k.scopedKeeper.ClaimCapability(ctx, cap, name); err != nil {
+			return err
+		}
+		if err := legacyKeeper.ReleaseCapability(ctx, cap); err != nil {
+			return err
+		}
+
+		k.Logger(ctx).Info("migrated interchain account channel", "port_id", channel.PortId, "channel_id", channel.ChannelId)
+	}
+
+	return nil
+}
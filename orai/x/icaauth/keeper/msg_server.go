@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/oraichain/orai/x/icaauth/types"
+)
+
+var _ types.MsgServer = &msgServer{}
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the icaauth MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{Keeper: k}
+}
+
+func (k msgServer) RegisterAccount(goCtx context.Context, msg *types.MsgRegisterAccount) (*types.MsgRegisterAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	portID, err := k.Keeper.RegisterAccount(ctx, msg.Owner, msg.ConnectionId, msg.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterAccountResponse{PortId: portID}, nil
+}
+
+func (k msgServer) SubmitTx(goCtx context.Context, msg *types.MsgSubmitTx) (*types.MsgSubmitTxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(msg.Timeout).UnixNano())
+	channelID, sequence, err := k.Keeper.SubmitTx(ctx, msg.Owner, msg.ConnectionId, msg.Msgs, msg.Memo, timeoutTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitTxResponse{ChannelId: channelID, Sequence: sequence}, nil
+}
@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/icaauth module sentinel errors
+var (
+	ErrTooManyMsgs     = sdkerrors.Register(ModuleName, 2, "too many msgs in interchain tx")
+	ErrInvalidTimeout  = sdkerrors.Register(ModuleName, 3, "invalid interchain tx timeout")
+	ErrAccountNotFound = sdkerrors.Register(ModuleName, 4, "interchain account not found")
+)
@@ -0,0 +1,18 @@
+package types
+
+// icaauth module event types
+const (
+	EventTypeRegisterAccount = "register_interchain_account"
+	EventTypeSubmitTx        = "submit_interchain_tx"
+	EventTypeAcknowledgement = "interchain_tx_acknowledgement"
+	EventTypeTimeout         = "interchain_tx_timeout"
+	EventTypeCallbackFailed  = "interchain_tx_callback_failed"
+
+	AttributeKeyOwner        = "owner"
+	AttributeKeyConnectionID = "connection_id"
+	AttributeKeyPortID       = "port_id"
+	AttributeKeyChannelID    = "channel_id"
+	AttributeKeySequence     = "sequence"
+	AttributeKeySuccess      = "success"
+	AttributeKeyError        = "error"
+)
@@ -0,0 +1,30 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+)
+
+// ICAControllerKeeper defines the expected interchain accounts controller
+// keeper.
+type ICAControllerKeeper interface {
+	RegisterInterchainAccount(ctx sdk.Context, connectionID, owner, version string) error
+	GetActiveChannelID(ctx sdk.Context, connectionID, portID string) (string, bool)
+	GetInterchainAccountAddress(ctx sdk.Context, connectionID, portID string) (string, bool)
+	SendTx(ctx sdk.Context, chanCap *capabilitytypes.Capability, connectionID, portID string, icaPacketData icatypes.InterchainAccountPacketData, timeoutTimestamp uint64) (uint64, error)
+}
+
+// ScopedKeeper defines the expected capability keeper scoped to a module.
+type ScopedKeeper interface {
+	GetCapability(ctx sdk.Context, name string) (*capabilitytypes.Capability, bool)
+	ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error
+	ReleaseCapability(ctx sdk.Context, cap *capabilitytypes.Capability) error
+}
+
+// ContractKeeper defines the expected wasm keeper, calling back the contracts
+// owning interchain accounts.
+type ContractKeeper interface {
+	HasContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) bool
+	Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+}
@@ -0,0 +1,139 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	"github.com/gogo/protobuf/proto"
+)
+
+const (
+	TypeMsgRegisterAccount = "register_account"
+	TypeMsgSubmitTx        = "submit_tx"
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterAccount{}
+	_ sdk.Msg = &MsgSubmitTx{}
+
+	_ codectypes.UnpackInterfacesMessage = MsgSubmitTx{}
+)
+
+// NewMsgRegisterAccount creates a new MsgRegisterAccount instance.
+func NewMsgRegisterAccount(owner sdk.AccAddress, connectionID, version string) *MsgRegisterAccount {
+	return &MsgRegisterAccount{
+		Owner:        owner.String(),
+		ConnectionId: connectionID,
+		Version:      version,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgRegisterAccount) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgRegisterAccount) Type() string { return TypeMsgRegisterAccount }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRegisterAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", err)
+	}
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid connection id: %s", err)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgRegisterAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRegisterAccount) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(msg.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+// NewMsgSubmitTx creates a new MsgSubmitTx instance.
+func NewMsgSubmitTx(owner sdk.AccAddress, connectionID string, msgs []sdk.Msg, memo string, timeout time.Duration) (*MsgSubmitTx, error) {
+	anys, err := PackTxMsgAnys(msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgSubmitTx{
+		Owner:        owner.String(),
+		ConnectionId: connectionID,
+		Msgs:         anys,
+		Memo:         memo,
+		Timeout:      timeout,
+	}, nil
+}
+
+// PackTxMsgAnys packs the msgs to execute on the host chain.
+func PackTxMsgAnys(msgs []sdk.Msg) ([]*codectypes.Any, error) {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		protoMsg, ok := msg.(proto.Message)
+		if !ok {
+			return nil, fmt.Errorf("can't proto marshal %T", msg)
+		}
+		any, err := codectypes.NewAnyWithValue(protoMsg)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = any
+	}
+	return anys, nil
+}
+
+// Route returns the name of the module
+func (msg MsgSubmitTx) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgSubmitTx) Type() string { return TypeMsgSubmitTx }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSubmitTx) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", err)
+	}
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid connection id: %s", err)
+	}
+	if len(msg.Msgs) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no msgs to submit")
+	}
+	if msg.Timeout <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidTimeout, "timeout must be positive: %s", msg.Timeout)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgSubmitTx) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSubmitTx) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(msg.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+// UnpackInterfaces implements codectypes.UnpackInterfacesMessage
+func (msg MsgSubmitTx) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, any := range msg.Msgs {
+		var sdkMsg sdk.Msg
+		if err := unpacker.UnpackAny(any, &sdkMsg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// DefaultMaxMsgs is the default number of msgs an interchain tx can contain.
+	DefaultMaxMsgs uint64 = 16
+	// DefaultCallbackGasLimit is the default gas of a contract callback.
+	DefaultCallbackGasLimit uint64 = 1_000_000
+)
+
+// Parameter store keys
+var (
+	KeyMaxMsgs          = []byte("MaxMsgs")
+	KeyCallbackGasLimit = []byte("CallbackGasLimit")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable the param key table for the icaauth module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(maxMsgs, callbackGasLimit uint64) Params {
+	return Params{
+		MaxMsgs:          maxMsgs,
+		CallbackGasLimit: callbackGasLimit,
+	}
+}
+
+// DefaultParams returns default parameters
+func DefaultParams() Params {
+	return NewParams(DefaultMaxMsgs, DefaultCallbackGasLimit)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxMsgs, &p.MaxMsgs, validateMaxMsgs),
+		paramtypes.NewParamSetPair(KeyCallbackGasLimit, &p.CallbackGasLimit, validateCallbackGasLimit),
+	}
+}
+
+// Validate performs basic validation.
+func (p Params) Validate() error {
+	if err := validateMaxMsgs(p.MaxMsgs); err != nil {
+		return err
+	}
+	return validateCallbackGasLimit(p.CallbackGasLimit)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+func validateMaxMsgs(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateCallbackGasLimit(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
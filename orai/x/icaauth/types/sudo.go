@@ -0,0 +1,25 @@
+package types
+
+// SudoMsg is sent to a contract owning an interchain account when a tx it
+// submitted is acknowledged by the host chain or times out. A timeout closes
+// the channel, the contract registers the account again to reopen it.
+type SudoMsg struct {
+	ICAAcknowledgement *ICAAcknowledgement `json:"ica_acknowledgement,omitempty"`
+	ICATimeout         *ICATimeout         `json:"ica_timeout,omitempty"`
+}
+
+// ICAAcknowledgement is the result of a tx executed by an interchain account.
+// Result holds the protobuf encoded sdk.TxMsgData of a successful tx, Error
+// the error of a failed one.
+type ICAAcknowledgement struct {
+	ChannelID string `json:"channel_id"`
+	Sequence  uint64 `json:"sequence"`
+	Result    []byte `json:"result,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// ICATimeout identifies a tx that timed out before reaching the host chain.
+type ICATimeout struct {
+	ChannelID string `json:"channel_id"`
+	Sequence  uint64 `json:"sequence"`
+}
@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	"github.com/oraichain/orai/x/icq/types"
+)
+
+const (
+	FlagTimeout = "timeout"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Interchain queries transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(
+		GetCmdSendQuery(),
+	)
+	return txCmd
+}
+
+func GetCmdSendQuery() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-query [channel-id] [path] [hex-data]",
+		Short: "Query the host chain of the channel",
+		Long: `Query the host chain of the channel. The path is a gRPC method, with the
+hex encoded request as data, or /store/<store name>/key with the hex encoded key.
+The responses are emitted in the interchain_query_result event.`,
+		Example: "oraid tx icq send-query channel-0 /cosmos.bank.v1beta1.Query/AllBalances 0a2b6f72616931... --from sender",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			data, err := hex.DecodeString(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid hex data: %w", err)
+			}
+
+			timeout, err := cmd.Flags().GetDuration(FlagTimeout)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSendQuery(clientCtx.GetFromAddress(), args[0], []types.QueryRequest{{Path: args[1], Data: data}}, timeout)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().Duration(FlagTimeout, time.Hour, "Time after the current block time at which the packet times out")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,35 @@
+package icq
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/oraichain/orai/x/icq/keeper"
+	"github.com/oraichain/orai/x/icq/types"
+)
+
+// InitGenesis import module genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	data types.GenesisState,
+) {
+	if err := data.Validate(); err != nil {
+		panic(err)
+	}
+
+	k.SetParams(ctx, data.Params)
+
+	// bind the host and controller ports, claiming their capabilities
+	for _, portID := range []string{types.HostPortID, types.ControllerPortID} {
+		if !k.IsBound(ctx, portID) {
+			if err := k.BindPort(ctx, portID); err != nil {
+				panic(err)
+			}
+		}
+	}
+}
+
+// ExportGenesis export module state
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx))
+}
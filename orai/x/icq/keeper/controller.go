@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	"encoding/json"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/oraichain/orai/x/icq/types"
+)
+
+// SendQuery sends the requests to the host chain of a channel of the
+// controller port. It returns the sequence of the packet, the sender gets the
+// result in an event or, for a contract, a callback.
+func (k Keeper) SendQuery(ctx sdk.Context, sender sdk.AccAddress, channelID string, reqs []abci.RequestQuery, timeoutTimestamp uint64) (uint64, error) {
+	channel, found := k.channelKeeper.GetChannel(ctx, types.ControllerPortID, channelID)
+	if !found {
+		return 0, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port %s, channel %s", types.ControllerPortID, channelID)
+	}
+	if channel.State != channeltypes.OPEN {
+		return 0, sdkerrors.Wrapf(channeltypes.ErrInvalidChannelState, "channel %s is %s", channelID, channel.State)
+	}
+
+	chanCap, found := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(types.ControllerPortID, channelID))
+	if !found {
+		return 0, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, types.ControllerPortID, channelID)
+	if !found {
+		return 0, sdkerrors.Wrapf(channeltypes.ErrSequenceSendNotFound, "port %s, channel %s", types.ControllerPortID, channelID)
+	}
+
+	bz, err := types.SerializeCosmosQuery(reqs)
+	if err != nil {
+		return 0, err
+	}
+	data := types.InterchainQueryPacketData{Data: bz}
+
+	packet := channeltypes.NewPacket(
+		data.GetBytes(),
+		sequence,
+		types.ControllerPortID,
+		channelID,
+		channel.Counterparty.PortId,
+		channel.Counterparty.ChannelId,
+		clienttypes.ZeroHeight(),
+		timeoutTimestamp,
+	)
+	if err := k.ics4Wrapper.SendPacket(ctx, chanCap, packet); err != nil {
+		return 0, err
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.GetPendingQueryKey(channelID, sequence), sender)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSendQuery,
+			sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(sequence, 10)),
+		),
+	)
+
+	return sequence, nil
+}
+
+// OnAcknowledgementPacket emits the result of a query and passes it to the
+// sender if it is a contract.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement) error {
+	result := &types.ICQResult{
+		ChannelID: packet.SourceChannel,
+		Sequence:  packet.Sequence,
+		Error:     ack.GetError(),
+	}
+	if ack.Success() {
+		var packetAck types.InterchainQueryPacketAck
+		if err := types.ModuleCdc.UnmarshalJSON(ack.GetResult(), &packetAck); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidPacketData, "cannot unmarshal icq acknowledgement: %s", err)
+		}
+		resps, err := types.DeserializeCosmosResponse(packetAck.Data)
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidPacketData, "cannot unmarshal icq responses: %s", err)
+		}
+
+		result.Responses = make([]types.QueryResponse, len(resps))
+		for i, resp := range resps {
+			result.Responses[i] = types.QueryResponse{Code: resp.Code, Value: resp.Value, Height: resp.Height}
+		}
+	}
+
+	sender := k.popPendingQuery(ctx, packet)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeQueryResult,
+			sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyChannelID, packet.SourceChannel),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(ack.Success())),
+			sdk.NewAttribute(types.AttributeKeyError, ack.GetError()),
+		),
+	)
+
+	k.callbackContract(ctx, sender, packet, types.SudoMsg{ICQResult: result})
+	return nil
+}
+
+// OnTimeoutPacket emits the timeout of a query and passes it to the sender if
+// it is a contract.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) {
+	sender := k.popPendingQuery(ctx, packet)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeQueryTimeout,
+			sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyChannelID, packet.SourceChannel),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+		),
+	)
+
+	k.callbackContract(ctx, sender, packet, types.SudoMsg{
+		ICQTimeout: &types.ICQTimeout{
+			ChannelID: packet.SourceChannel,
+			Sequence:  packet.Sequence,
+		},
+	})
+}
+
+// popPendingQuery returns and deletes the sender of the query, nil if the
+// query was sent before the module kept track of them.
+func (k Keeper) popPendingQuery(ctx sdk.Context, packet channeltypes.Packet) sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetPendingQueryKey(packet.SourceChannel, packet.Sequence)
+	sender := store.Get(key)
+	store.Delete(key)
+	return sender
+}
+
+// callbackContract calls the contract that sent the query with the sudo msg,
+// within the callback gas limit. The packet is acknowledged whatever the
+// contract does: its state changes are discarded and the error emitted when
+// it fails, so that a contract cannot block the channel.
+func (k Keeper) callbackContract(ctx sdk.Context, sender sdk.AccAddress, packet channeltypes.Packet, msg types.SudoMsg) {
+	if sender.Empty() || !k.contractKeeper.HasContractInfo(ctx, sender) {
+		return
+	}
+
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	if err := k.sudo(ctx, sender, bz); err != nil {
+		k.Logger(ctx).Debug("contract callback failed", "contract", sender.String(), "channel_id", packet.SourceChannel, "sequence", packet.Sequence, "error", err)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCallbackFailed,
+				sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+				sdk.NewAttribute(types.AttributeKeyChannelID, packet.SourceChannel),
+				sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			),
+		)
+	}
+}
+
+func (k Keeper) sudo(ctx sdk.Context, contract sdk.AccAddress, msg []byte) (err error) {
+	gasLimit := k.GetParams(ctx).CallbackGasLimit
+	gasMeter := sdk.NewGasMeter(gasLimit)
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter)
+
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "callback exceeds gas limit %d", gasLimit)
+		}
+		ctx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), "icq callback")
+	}()
+
+	if _, err := k.contractKeeper.Sudo(cacheCtx, contract, msg); err != nil {
+		return err
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
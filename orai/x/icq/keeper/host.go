@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/oraichain/orai/x/icq/types"
+)
+
+// OnRecvPacket answers the queries of a packet received on the host port. It
+// returns the encoded acknowledgement, or an error if the host is disabled or
+// a query is not allowed or fails.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byte, error) {
+	params := k.GetParams(ctx)
+	if !params.HostEnabled {
+		return nil, types.ErrHostDisabled
+	}
+
+	var data types.InterchainQueryPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPacketData, "cannot unmarshal icq packet data: %s", err)
+	}
+	if err := data.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	reqs, err := types.DeserializeCosmosQuery(data.Data)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPacketData, "cannot unmarshal icq requests: %s", err)
+	}
+
+	// queries must not change the state, whatever the handlers do
+	queryCtx, _ := ctx.CacheContext()
+	resps := make([]abci.ResponseQuery, len(reqs))
+	for i, req := range reqs {
+		if !params.IsAllowed(req.Path) {
+			return nil, sdkerrors.Wrapf(types.ErrQueryNotAllowed, "path %s", req.Path)
+		}
+		if req.Height != 0 || req.Prove {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "queries are answered at the current height without proof")
+		}
+
+		resps[i], err = k.query(queryCtx, req)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	bz, err := types.SerializeCosmosResponse(resps)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHostQuery,
+			sdk.NewAttribute(types.AttributeKeyChannelID, packet.DestinationChannel),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+		),
+	)
+
+	return types.InterchainQueryPacketAck{Data: bz}.GetBytes(), nil
+}
+
+// query answers a gRPC query or a store read.
+func (k Keeper) query(ctx sdk.Context, req abci.RequestQuery) (abci.ResponseQuery, error) {
+	if strings.HasPrefix(req.Path, types.StoreQueryPathPrefix) {
+		name := strings.TrimSuffix(strings.TrimPrefix(req.Path, types.StoreQueryPathPrefix), "/key")
+		key, ok := k.storeKeys[name]
+		if !ok || req.Path != types.StoreQueryPathPrefix+name+"/key" {
+			return abci.ResponseQuery{}, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown store query %s", req.Path)
+		}
+
+		return abci.ResponseQuery{
+			Key:    req.Data,
+			Value:  ctx.KVStore(key).Get(req.Data),
+			Height: ctx.BlockHeight(),
+		}, nil
+	}
+
+	route := k.queryRouter.Route(req.Path)
+	if route == nil {
+		return abci.ResponseQuery{}, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "no route for query %s", req.Path)
+	}
+
+	res, err := route(ctx, req)
+	if err != nil {
+		return abci.ResponseQuery{}, err
+	}
+
+	return abci.ResponseQuery{
+		Code:   res.Code,
+		Index:  res.Index,
+		Key:    res.Key,
+		Value:  res.Value,
+		Height: ctx.BlockHeight(),
+	}, nil
+}
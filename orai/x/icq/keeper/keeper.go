@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/oraichain/orai/x/icq/types"
+)
+
+// Keeper of the icq module. It answers the queries of counterparty chains
+// on the host port and sends the queries of this chain on the controller
+// port.
+type Keeper struct {
+	storeKey   sdk.StoreKey
+	cdc        codec.BinaryCodec
+	paramSpace paramtypes.Subspace
+
+	ics4Wrapper    types.ICS4Wrapper
+	channelKeeper  types.ChannelKeeper
+	portKeeper     types.PortKeeper
+	scopedKeeper   types.ScopedKeeper
+	contractKeeper types.ContractKeeper
+
+	// queryRouter and storeKeys answer the gRPC and store queries of the host
+	queryRouter *baseapp.GRPCQueryRouter
+	storeKeys   map[string]*sdk.KVStoreKey
+}
+
+func NewKeeper(
+	storeKey sdk.StoreKey,
+	cdc codec.BinaryCodec,
+	paramSpace paramtypes.Subspace,
+	ics4Wrapper types.ICS4Wrapper,
+	channelKeeper types.ChannelKeeper,
+	portKeeper types.PortKeeper,
+	scopedKeeper types.ScopedKeeper,
+	contractKeeper types.ContractKeeper,
+	queryRouter *baseapp.GRPCQueryRouter,
+	storeKeys map[string]*sdk.KVStoreKey,
+) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:       storeKey,
+		cdc:            cdc,
+		paramSpace:     paramSpace,
+		ics4Wrapper:    ics4Wrapper,
+		channelKeeper:  channelKeeper,
+		portKeeper:     portKeeper,
+		scopedKeeper:   scopedKeeper,
+		contractKeeper: contractKeeper,
+		queryRouter:    queryRouter,
+		storeKeys:      storeKeys,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns the current x/icq module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (p types.Params) {
+	k.paramSpace.GetParamSet(ctx, &p)
+	return p
+}
+
+// SetParams sets the x/icq module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, p types.Params) {
+	k.paramSpace.SetParamSet(ctx, &p)
+}
+
+// IsBound checks if the module already owns the port.
+func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
+	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
+	return ok
+}
+
+// BindPort binds the port and claims its capability.
+func (k Keeper) BindPort(ctx sdk.Context, portID string) error {
+	cap := k.portKeeper.BindPort(ctx, portID)
+	return k.ClaimCapability(ctx, cap, host.PortPath(portID))
+}
+
+// ClaimCapability claims the capability passed by the IBC module.
+func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error {
+	return k.scopedKeeper.ClaimCapability(ctx, cap, name)
+}
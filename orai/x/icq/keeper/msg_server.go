@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/oraichain/orai/x/icq/types"
+)
+
+var _ types.MsgServer = &msgServer{}
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the icq MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{Keeper: k}
+}
+
+func (k msgServer) SendQuery(goCtx context.Context, msg *types.MsgSendQuery) (*types.MsgSendQueryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(msg.Timeout).UnixNano())
+	sequence, err := k.Keeper.SendQuery(ctx, sender, msg.ChannelId, msg.ABCIRequests(), timeoutTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSendQueryResponse{Sequence: sequence}, nil
+}
@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/icq module sentinel errors
+var (
+	ErrHostDisabled      = sdkerrors.Register(ModuleName, 2, "icq host is disabled")
+	ErrQueryNotAllowed   = sdkerrors.Register(ModuleName, 3, "query path not allowed")
+	ErrInvalidVersion    = sdkerrors.Register(ModuleName, 4, "invalid icq version")
+	ErrInvalidChannel    = sdkerrors.Register(ModuleName, 5, "invalid icq channel")
+	ErrInvalidTimeout    = sdkerrors.Register(ModuleName, 6, "invalid icq timeout")
+	ErrInvalidPacketData = sdkerrors.Register(ModuleName, 7, "invalid icq packet data")
+)
@@ -0,0 +1,16 @@
+package types
+
+// icq module event types
+const (
+	EventTypeSendQuery      = "send_interchain_query"
+	EventTypeHostQuery      = "host_interchain_query"
+	EventTypeQueryResult    = "interchain_query_result"
+	EventTypeQueryTimeout   = "interchain_query_timeout"
+	EventTypeCallbackFailed = "interchain_query_callback_failed"
+
+	AttributeKeySender    = "sender"
+	AttributeKeyChannelID = "channel_id"
+	AttributeKeySequence  = "sequence"
+	AttributeKeySuccess   = "success"
+	AttributeKeyError     = "error"
+)
@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "icq"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+
+	// Version is the version of the icq channels
+	Version = "icq-1"
+
+	// HostPortID is the port answering the queries of counterparty chains
+	HostPortID = "icqhost"
+
+	// ControllerPortID is the port sending the queries of this chain
+	ControllerPortID = "icqcontroller"
+
+	// StoreQueryPathPrefix prefixes the paths of raw store reads, followed by
+	// the store name and "/key"
+	StoreQueryPathPrefix = "/store/"
+)
+
+var (
+	// PendingQueryPrefix prefixes the senders of the queries waiting for
+	// their result
+	PendingQueryPrefix = []byte{0x01}
+)
+
+// GetPendingQueryKey returns the store key of the sender of a query sent on
+// the channel.
+func GetPendingQueryKey(channelID string, sequence uint64) []byte {
+	return append(append(PendingQueryPrefix, address.MustLengthPrefix([]byte(channelID))...), sdk.Uint64ToBigEndian(sequence)...)
+}
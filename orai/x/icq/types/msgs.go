@@ -0,0 +1,73 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+const TypeMsgSendQuery = "send_query"
+
+var _ sdk.Msg = &MsgSendQuery{}
+
+// NewMsgSendQuery creates a new MsgSendQuery instance.
+func NewMsgSendQuery(sender sdk.AccAddress, channelID string, requests []QueryRequest, timeout time.Duration) *MsgSendQuery {
+	return &MsgSendQuery{
+		Sender:    sender.String(),
+		ChannelId: channelID,
+		Requests:  requests,
+		Timeout:   timeout,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgSendQuery) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgSendQuery) Type() string { return TypeMsgSendQuery }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSendQuery) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid channel id: %s", err)
+	}
+	if len(msg.Requests) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no queries to send")
+	}
+	for _, req := range msg.Requests {
+		if !strings.HasPrefix(req.Path, "/") {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "query path %q must start with /", req.Path)
+		}
+	}
+	if msg.Timeout <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidTimeout, "timeout must be positive: %s", msg.Timeout)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgSendQuery) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSendQuery) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// ABCIRequests returns the requests as sent in the query packet.
+func (msg MsgSendQuery) ABCIRequests() []abci.RequestQuery {
+	reqs := make([]abci.RequestQuery, len(msg.Requests))
+	for i, req := range msg.Requests {
+		reqs[i] = abci.RequestQuery{Path: req.Path, Data: req.Data}
+	}
+	return reqs
+}
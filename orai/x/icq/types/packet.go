@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// ValidateBasic checks that the packet carries queries.
+func (pd InterchainQueryPacketData) ValidateBasic() error {
+	if len(pd.Data) == 0 {
+		return sdkerrors.Wrap(ErrInvalidPacketData, "packet data cannot be empty")
+	}
+	return nil
+}
+
+// GetBytes returns the JSON encoding of the packet data.
+func (pd InterchainQueryPacketData) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&pd))
+}
+
+// GetBytes returns the JSON encoding of the packet acknowledgement.
+func (ack InterchainQueryPacketAck) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&ack))
+}
+
+// SerializeCosmosQuery encodes the requests of a query packet.
+func SerializeCosmosQuery(reqs []abci.RequestQuery) ([]byte, error) {
+	return ModuleCdc.Marshal(&CosmosQuery{Requests: reqs})
+}
+
+// DeserializeCosmosQuery decodes the requests of a query packet.
+func DeserializeCosmosQuery(bz []byte) ([]abci.RequestQuery, error) {
+	var query CosmosQuery
+	if err := ModuleCdc.Unmarshal(bz, &query); err != nil {
+		return nil, err
+	}
+	return query.Requests, nil
+}
+
+// SerializeCosmosResponse encodes the responses of a query acknowledgement.
+func SerializeCosmosResponse(resps []abci.ResponseQuery) ([]byte, error) {
+	return ModuleCdc.Marshal(&CosmosResponse{Responses: resps})
+}
+
+// DeserializeCosmosResponse decodes the responses of a query acknowledgement.
+func DeserializeCosmosResponse(bz []byte) ([]abci.ResponseQuery, error) {
+	var response CosmosResponse
+	if err := ModuleCdc.Unmarshal(bz, &response); err != nil {
+		return nil, err
+	}
+	return response.Responses, nil
+}
@@ -0,0 +1,113 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"gopkg.in/yaml.v2"
+)
+
+// DefaultCallbackGasLimit is the default gas of a contract callback.
+const DefaultCallbackGasLimit uint64 = 1_000_000
+
+// Parameter store keys
+var (
+	KeyHostEnabled      = []byte("HostEnabled")
+	KeyAllowQueries     = []byte("AllowQueries")
+	KeyCallbackGasLimit = []byte("CallbackGasLimit")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable the param key table for the icq module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(hostEnabled bool, allowQueries []string, callbackGasLimit uint64) Params {
+	return Params{
+		HostEnabled:      hostEnabled,
+		AllowQueries:     allowQueries,
+		CallbackGasLimit: callbackGasLimit,
+	}
+}
+
+// DefaultParams returns default parameters: the host is enabled but answers
+// no query until governance allows some.
+func DefaultParams() Params {
+	return NewParams(true, []string{}, DefaultCallbackGasLimit)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyHostEnabled, &p.HostEnabled, validateHostEnabled),
+		paramtypes.NewParamSetPair(KeyAllowQueries, &p.AllowQueries, validateAllowQueries),
+		paramtypes.NewParamSetPair(KeyCallbackGasLimit, &p.CallbackGasLimit, validateCallbackGasLimit),
+	}
+}
+
+// Validate performs basic validation.
+func (p Params) Validate() error {
+	if err := validateHostEnabled(p.HostEnabled); err != nil {
+		return err
+	}
+	if err := validateAllowQueries(p.AllowQueries); err != nil {
+		return err
+	}
+	return validateCallbackGasLimit(p.CallbackGasLimit)
+}
+
+// IsAllowed reports whether the host answers queries of the path.
+func (p Params) IsAllowed(path string) bool {
+	for _, allowed := range p.AllowQueries {
+		if allowed == path {
+			return true
+		}
+	}
+	return false
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+func validateHostEnabled(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateAllowQueries(i interface{}) error {
+	paths, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := map[string]bool{}
+	for _, path := range paths {
+		if !strings.HasPrefix(path, "/") {
+			return fmt.Errorf("query path %q must start with /", path)
+		}
+		if seen[path] {
+			return fmt.Errorf("query path %s listed twice", path)
+		}
+		seen[path] = true
+	}
+
+	return nil
+}
+
+func validateCallbackGasLimit(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package types
+
+// SudoMsg is sent to a contract when the result of a query it sent is
+// acknowledged by the host chain, or when the query times out.
+type SudoMsg struct {
+	ICQResult  *ICQResult  `json:"icq_result,omitempty"`
+	ICQTimeout *ICQTimeout `json:"icq_timeout,omitempty"`
+}
+
+// ICQResult holds the responses to the requests of a query, in order, or the
+// error of the host chain.
+type ICQResult struct {
+	ChannelID string          `json:"channel_id"`
+	Sequence  uint64          `json:"sequence"`
+	Responses []QueryResponse `json:"responses,omitempty"`
+	Error     string          `json:"error,omitempty"`
+}
+
+// QueryResponse is the response to a request. Value holds the protobuf
+// encoded gRPC response or the value read in the store.
+type QueryResponse struct {
+	Code   uint32 `json:"code"`
+	Value  []byte `json:"value,omitempty"`
+	Height int64  `json:"height"`
+}
+
+// ICQTimeout identifies a query that timed out before reaching the host
+// chain.
+type ICQTimeout struct {
+	ChannelID string `json:"channel_id"`
+	Sequence  uint64 `json:"sequence"`
+}
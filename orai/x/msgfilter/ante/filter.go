@@ -0,0 +1,37 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/oraichain/orai/app/walker"
+)
+
+// MsgFilterKeeper defines the expected msgfilter keeper.
+type MsgFilterKeeper interface {
+	ValidateMsg(ctx sdk.Context, msg sdk.Msg) error
+}
+
+// MsgFilterDecorator rejects txs containing a disabled msg, including msgs
+// wrapped inside the msgs the walker descends into, such as authz MsgExec.
+type MsgFilterDecorator struct {
+	walker          walker.Walker
+	msgFilterKeeper MsgFilterKeeper
+}
+
+func NewMsgFilterDecorator(msgWalker walker.Walker, msgFilterKeeper MsgFilterKeeper) MsgFilterDecorator {
+	return MsgFilterDecorator{
+		walker:          msgWalker,
+		msgFilterKeeper: msgFilterKeeper,
+	}
+}
+
+func (mfd MsgFilterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	err = mfd.walker.Walk(tx.GetMsgs(), func(msg sdk.Msg, _ int) error {
+		return mfd.msgFilterKeeper.ValidateMsg(ctx, msg)
+	})
+	if err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
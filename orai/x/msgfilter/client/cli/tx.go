@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/oraichain/orai/x/msgfilter/types"
+)
+
+// NewCmdSubmitDisableMsgsProposal implements a command handler for submitting
+// a proposal to disable msg types.
+func NewCmdSubmitDisableMsgsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "disable-msgs [msg-type-url]...",
+		Args:    cobra.MinimumNArgs(1),
+		Short:   "Submit a proposal to reject msgs of the given types",
+		Example: "oraid tx gov submit-proposal disable-msgs /orai.icaauth.v1.MsgSubmitTx --title ... --description ... --deposit 10000000orai",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewDisableMsgsProposal(title, description, args)
+			})
+		},
+	}
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// NewCmdSubmitEnableMsgsProposal implements a command handler for submitting
+// a proposal to enable msg types again.
+func NewCmdSubmitEnableMsgsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "enable-msgs [msg-type-url]...",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Submit a proposal to accept msgs of the given types again",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewEnableMsgsProposal(title, description, args)
+			})
+		},
+	}
+	addProposalFlags(cmd)
+	return cmd
+}
+
+func submitProposal(cmd *cobra.Command, newContent func(title, description string) govtypes.Content) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return err
+	}
+
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(newContent(title, description), deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+
+	if err = msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+}
@@ -0,0 +1,26 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+
+	"github.com/oraichain/orai/x/msgfilter/client/cli"
+)
+
+var (
+	DisableMsgsProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitDisableMsgsProposal, emptyRestHandler)
+	EnableMsgsProposalHandler  = govclient.NewProposalHandler(cli.NewCmdSubmitEnableMsgsProposal, emptyRestHandler)
+)
+
+func emptyRestHandler(client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "unsupported-msgfilter",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Legacy REST Routes are not supported for msgfilter proposals")
+		},
+	}
+}
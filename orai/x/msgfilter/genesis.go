@@ -0,0 +1,28 @@
+package msgfilter
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/oraichain/orai/x/msgfilter/keeper"
+	"github.com/oraichain/orai/x/msgfilter/types"
+)
+
+// InitGenesis import module genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	data types.GenesisState,
+) {
+	if err := data.Validate(); err != nil {
+		panic(err)
+	}
+
+	for _, msgTypeURL := range data.DisabledMsgTypeUrls {
+		k.DisableMsg(ctx, msgTypeURL)
+	}
+}
+
+// ExportGenesis export module state
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(k.GetDisabledMsgs(ctx))
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/oraichain/orai/x/msgfilter/types"
+)
+
+// Keeper of the msgfilter store
+type Keeper struct {
+	storeKey storetypes.StoreKey
+}
+
+func NewKeeper(key storetypes.StoreKey) Keeper {
+	return Keeper{
+		storeKey: key,
+	}
+}
+
+// DisableMsg rejects msgs of the given type from now on.
+func (k Keeper) DisableMsg(ctx sdk.Context, msgTypeURL string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDisabledMsgKey(msgTypeURL), []byte{})
+}
+
+// EnableMsg accepts msgs of the given type again.
+func (k Keeper) EnableMsg(ctx sdk.Context, msgTypeURL string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDisabledMsgKey(msgTypeURL))
+}
+
+// IsDisabled returns true if msgs of the given type are rejected.
+func (k Keeper) IsDisabled(ctx sdk.Context, msgTypeURL string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetDisabledMsgKey(msgTypeURL))
+}
+
+// GetDisabledMsgs returns the type urls of all disabled msgs.
+func (k Keeper) GetDisabledMsgs(ctx sdk.Context) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DisabledMsgPrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	msgTypeURLs := []string{}
+	for ; iter.Valid(); iter.Next() {
+		msgTypeURLs = append(msgTypeURLs, string(iter.Key()))
+	}
+	return msgTypeURLs
+}
+
+// ValidateMsg returns an error if the msg type is disabled.
+func (k Keeper) ValidateMsg(ctx sdk.Context, msg sdk.Msg) error {
+	msgTypeURL := sdk.MsgTypeURL(msg)
+	if k.IsDisabled(ctx, msgTypeURL) {
+		return sdkerrors.Wrap(types.ErrMsgDisabled, msgTypeURL)
+	}
+	return nil
+}
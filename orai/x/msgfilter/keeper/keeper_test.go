@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/oraichain/orai/x/msgfilter/keeper"
+	"github.com/oraichain/orai/x/msgfilter/types"
+)
+
+func setupKeeper(t *testing.T) (sdk.Context, keeper.Keeper) {
+	key := sdk.NewKVStoreKey(types.StoreKey)
+
+	ms := store.NewCommitMultiStore(dbm.NewMemDB())
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	return sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger()), keeper.NewKeeper(key)
+}
+
+func TestProposalHandler(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	handler := keeper.NewProposalHandler(k)
+
+	addr := sdk.AccAddress("addr1_______________")
+	send := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("orai", 1)))
+	sendURL := sdk.MsgTypeURL(send)
+	multiSendURL := sdk.MsgTypeURL(&banktypes.MsgMultiSend{})
+
+	require.NoError(t, k.ValidateMsg(ctx, send))
+
+	err := handler(ctx, types.NewDisableMsgsProposal("title", "description", []string{sendURL, multiSendURL}))
+	require.NoError(t, err)
+	require.ErrorIs(t, k.ValidateMsg(ctx, send), types.ErrMsgDisabled)
+	require.ElementsMatch(t, []string{sendURL, multiSendURL}, k.GetDisabledMsgs(ctx))
+
+	err = handler(ctx, types.NewEnableMsgsProposal("title", "description", []string{sendURL}))
+	require.NoError(t, err)
+	require.NoError(t, k.ValidateMsg(ctx, send))
+	require.Equal(t, []string{multiSendURL}, k.GetDisabledMsgs(ctx))
+}
+
+func TestDisableMsgsProposalValidateBasic(t *testing.T) {
+	cases := map[string]struct {
+		msgTypeURLs []string
+		expErr      bool
+	}{
+		"valid":              {msgTypeURLs: []string{"/intertx.MsgSubmitTx"}},
+		"empty":              {expErr: true},
+		"not a type url":     {msgTypeURLs: []string{"intertx.MsgSubmitTx"}, expErr: true},
+		"duplicate type url": {msgTypeURLs: []string{"/intertx.MsgSubmitTx", "/intertx.MsgSubmitTx"}, expErr: true},
+		"gov msg":            {msgTypeURLs: []string{"/cosmos.gov.v1beta1.MsgVote"}, expErr: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := types.NewDisableMsgsProposal("title", "description", tc.msgTypeURLs).ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/oraichain/orai/x/msgfilter/types"
+)
+
+// NewProposalHandler returns the gov handler of the msgfilter proposals.
+func NewProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.DisableMsgsProposal:
+			return k.HandleDisableMsgsProposal(ctx, c)
+		case *types.EnableMsgsProposal:
+			return k.HandleEnableMsgsProposal(ctx, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized msgfilter proposal content type: %T", c)
+		}
+	}
+}
+
+func (k Keeper) HandleDisableMsgsProposal(ctx sdk.Context, p *types.DisableMsgsProposal) error {
+	for _, msgTypeURL := range p.MsgTypeUrls {
+		k.DisableMsg(ctx, msgTypeURL)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDisableMsgs,
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURLs, strings.Join(p.MsgTypeUrls, ",")),
+		),
+	)
+	return nil
+}
+
+func (k Keeper) HandleEnableMsgsProposal(ctx sdk.Context, p *types.EnableMsgsProposal) error {
+	for _, msgTypeURL := range p.MsgTypeUrls {
+		k.EnableMsg(ctx, msgTypeURL)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEnableMsgs,
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURLs, strings.Join(p.MsgTypeUrls, ",")),
+		),
+	)
+	return nil
+}
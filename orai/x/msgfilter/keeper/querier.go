@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/oraichain/orai/x/msgfilter/types"
+)
+
+var _ types.QueryServer = &Querier{}
+
+type Querier struct {
+	keeper Keeper
+}
+
+func NewQuerier(k Keeper) Querier {
+	return Querier{
+		keeper: k,
+	}
+}
+
+// DisabledMsgs returns the msg types that are rejected.
+func (q Querier) DisabledMsgs(stdCtx context.Context, _ *types.QueryDisabledMsgsRequest) (*types.QueryDisabledMsgsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+
+	return &types.QueryDisabledMsgsResponse{
+		MsgTypeUrls: q.keeper.GetDisabledMsgs(ctx),
+	}, nil
+}
@@ -0,0 +1,107 @@
+package msgfilter
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+
+	"github.com/oraichain/orai/app/walker"
+	"github.com/oraichain/orai/x/msgfilter/keeper"
+)
+
+// MessageRouter routes msgs to their handler, as the baseapp
+// MsgServiceRouter does.
+type MessageRouter interface {
+	Handler(msg sdk.Msg) baseapp.MsgServiceHandler
+}
+
+type filteredRouter struct {
+	router MessageRouter
+	keeper keeper.Keeper
+	walker walker.Walker
+}
+
+// NewMessageRouter wraps a router so that disabled msgs are rejected. It is
+// used for the msgs dispatched by contracts, which don't go through the ante
+// handler.
+func NewMessageRouter(router MessageRouter, k keeper.Keeper, msgWalker walker.Walker) MessageRouter {
+	return filteredRouter{
+		router: router,
+		keeper: k,
+		walker: msgWalker,
+	}
+}
+
+func (r filteredRouter) Handler(msg sdk.Msg) baseapp.MsgServiceHandler {
+	handler := r.router.Handler(msg)
+	if handler == nil {
+		return nil
+	}
+
+	return func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error) {
+		err := r.walker.Walk([]sdk.Msg{req}, func(msg sdk.Msg, _ int) error {
+			return r.keeper.ValidateMsg(ctx, msg)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+type methodHandler = func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error)
+
+type filteredMsgServer struct {
+	server gogogrpc.Server
+	keeper keeper.Keeper
+}
+
+// NewMsgServer wraps the server msg services are registered with, usually
+// the baseapp MsgServiceRouter, so that every registered handler rejects
+// disabled msgs. Modules executing msgs through the router, such as the
+// interchain account host and authz, then fail the disabled msgs themselves,
+// the host with an error acknowledgement rather than a failed relay tx.
+func NewMsgServer(server gogogrpc.Server, k keeper.Keeper) gogogrpc.Server {
+	return filteredMsgServer{
+		server: server,
+		keeper: k,
+	}
+}
+
+func (s filteredMsgServer) RegisterService(sd *grpc.ServiceDesc, ss interface{}) {
+	desc := *sd
+	desc.Methods = make([]grpc.MethodDesc, len(sd.Methods))
+	for i, method := range sd.Methods {
+		desc.Methods[i] = grpc.MethodDesc{
+			MethodName: method.MethodName,
+			Handler:    s.filterMethod(method.Handler),
+		}
+	}
+
+	s.server.RegisterService(&desc, ss)
+}
+
+// filterMethod checks the msg handed to the method by the interceptor, which
+// is where the MsgServiceRouter passes the msg and the sdk context.
+func (s filteredMsgServer) filterMethod(handler methodHandler) methodHandler {
+	return func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		if interceptor == nil {
+			return handler(srv, ctx, dec, nil)
+		}
+
+		return handler(srv, ctx, dec, func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, next grpc.UnaryHandler) (interface{}, error) {
+			return interceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				if msg, ok := req.(sdk.Msg); ok {
+					if err := s.keeper.ValidateMsg(sdk.UnwrapSDKContext(ctx), msg); err != nil {
+						return nil, err
+					}
+				}
+				return next(ctx, req)
+			})
+		})
+	}
+}
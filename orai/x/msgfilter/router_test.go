@@ -0,0 +1,70 @@
+package msgfilter_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/oraichain/orai/app/walker"
+	"github.com/oraichain/orai/x/msgfilter"
+	"github.com/oraichain/orai/x/msgfilter/keeper"
+	"github.com/oraichain/orai/x/msgfilter/types"
+)
+
+type routerFunc func(msg sdk.Msg) baseapp.MsgServiceHandler
+
+func (f routerFunc) Handler(msg sdk.Msg) baseapp.MsgServiceHandler { return f(msg) }
+
+func TestMessageRouter(t *testing.T) {
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	ms := store.NewCommitMultiStore(dbm.NewMemDB())
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+	ctx := sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger())
+
+	k := keeper.NewKeeper(key)
+	registry := codectypes.NewInterfaceRegistry()
+	msgWalker := walker.New(codec.NewProtoCodec(registry), walker.DefaultMaxDepth)
+
+	addr := sdk.AccAddress("addr1_______________")
+	send := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("orai", 1)))
+	exec := authz.NewMsgExec(addr, []sdk.Msg{send})
+
+	var handled int
+	router := msgfilter.NewMessageRouter(routerFunc(func(msg sdk.Msg) baseapp.MsgServiceHandler {
+		if _, ok := msg.(*banktypes.MsgMultiSend); ok {
+			return nil
+		}
+		return func(ctx sdk.Context, _ sdk.Msg) (*sdk.Result, error) {
+			handled++
+			return &sdk.Result{}, nil
+		}
+	}), k, msgWalker)
+
+	// unknown msgs stay unknown
+	require.Nil(t, router.Handler(&banktypes.MsgMultiSend{}))
+
+	_, err := router.Handler(send)(ctx, send)
+	require.NoError(t, err)
+	_, err = router.Handler(&exec)(ctx, &exec)
+	require.NoError(t, err)
+	require.Equal(t, 2, handled)
+
+	k.DisableMsg(ctx, sdk.MsgTypeURL(send))
+
+	_, err = router.Handler(send)(ctx, send)
+	require.ErrorIs(t, err, types.ErrMsgDisabled)
+	_, err = router.Handler(&exec)(ctx, &exec)
+	require.ErrorIs(t, err, types.ErrMsgDisabled)
+	require.Equal(t, 2, handled)
+}
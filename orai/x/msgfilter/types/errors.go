@@ -0,0 +1,10 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/msgfilter module sentinel errors
+var (
+	ErrMsgDisabled = sdkerrors.Register(ModuleName, 2, "msg type is disabled")
+)
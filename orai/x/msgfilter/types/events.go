@@ -0,0 +1,9 @@
+package types
+
+// msgfilter module event types
+const (
+	EventTypeDisableMsgs = "disable_msgs"
+	EventTypeEnableMsgs  = "enable_msgs"
+
+	AttributeKeyMsgTypeURLs = "msg_type_urls"
+)
@@ -0,0 +1,39 @@
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(disabledMsgTypeURLs []string) *GenesisState {
+	return &GenesisState{
+		DisabledMsgTypeUrls: disabledMsgTypeURLs,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state, no msg is disabled.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState([]string{})
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	if err := ValidateMsgTypeURLs(gs.DisabledMsgTypeUrls); err != nil {
+		return err
+	}
+	return validateDisableable(gs.DisabledMsgTypeUrls)
+}
+
+// ValidateMsgTypeURLs checks that the list is not empty, has no duplicates
+// and only contains type urls.
+func ValidateMsgTypeURLs(msgTypeURLs []string) error {
+	seen := make(map[string]bool, len(msgTypeURLs))
+	for _, msgTypeURL := range msgTypeURLs {
+		if len(msgTypeURL) < 2 || msgTypeURL[0] != '/' {
+			return fmt.Errorf("invalid msg type url: %q", msgTypeURL)
+		}
+		if seen[msgTypeURL] {
+			return fmt.Errorf("duplicate msg type url: %s", msgTypeURL)
+		}
+		seen[msgTypeURL] = true
+	}
+	return nil
+}
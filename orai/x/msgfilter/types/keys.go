@@ -0,0 +1,23 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "msgfilter"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// DisabledMsgPrefix prefixes the type urls of disabled msgs.
+var DisabledMsgPrefix = []byte{0x01}
+
+// GetDisabledMsgKey returns the store key of a disabled msg type.
+func GetDisabledMsgKey(msgTypeURL string) []byte {
+	return append(DisabledMsgPrefix, []byte(msgTypeURL)...)
+}
@@ -0,0 +1,111 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeDisableMsgs = "DisableMsgs"
+	ProposalTypeEnableMsgs  = "EnableMsgs"
+)
+
+// govMsgPrefix prefixes the gov msgs, which can't be disabled since a
+// proposal would then be needed to enable them again.
+const govMsgPrefix = "/cosmos.gov."
+
+var (
+	_ govtypes.Content = &DisableMsgsProposal{}
+	_ govtypes.Content = &EnableMsgsProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeDisableMsgs)
+	govtypes.RegisterProposalTypeCodec(&DisableMsgsProposal{}, "msgfilter/DisableMsgsProposal")
+	govtypes.RegisterProposalType(ProposalTypeEnableMsgs)
+	govtypes.RegisterProposalTypeCodec(&EnableMsgsProposal{}, "msgfilter/EnableMsgsProposal")
+}
+
+// NewDisableMsgsProposal creates a new DisableMsgsProposal instance.
+func NewDisableMsgsProposal(title, description string, msgTypeURLs []string) *DisableMsgsProposal {
+	return &DisableMsgsProposal{
+		Title:       title,
+		Description: description,
+		MsgTypeUrls: msgTypeURLs,
+	}
+}
+
+func (p *DisableMsgsProposal) GetTitle() string { return p.Title }
+
+func (p *DisableMsgsProposal) GetDescription() string { return p.Description }
+
+func (p *DisableMsgsProposal) ProposalRoute() string { return RouterKey }
+
+func (p *DisableMsgsProposal) ProposalType() string { return ProposalTypeDisableMsgs }
+
+func (p *DisableMsgsProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if len(p.MsgTypeUrls) == 0 {
+		return fmt.Errorf("no msg type urls")
+	}
+	if err := ValidateMsgTypeURLs(p.MsgTypeUrls); err != nil {
+		return err
+	}
+	return validateDisableable(p.MsgTypeUrls)
+}
+
+func (p DisableMsgsProposal) String() string {
+	return fmt.Sprintf(`Disable Msgs Proposal:
+  Title:         %s
+  Description:   %s
+  Msg Type URLs: %s
+`, p.Title, p.Description, strings.Join(p.MsgTypeUrls, ", "))
+}
+
+// NewEnableMsgsProposal creates a new EnableMsgsProposal instance.
+func NewEnableMsgsProposal(title, description string, msgTypeURLs []string) *EnableMsgsProposal {
+	return &EnableMsgsProposal{
+		Title:       title,
+		Description: description,
+		MsgTypeUrls: msgTypeURLs,
+	}
+}
+
+func (p *EnableMsgsProposal) GetTitle() string { return p.Title }
+
+func (p *EnableMsgsProposal) GetDescription() string { return p.Description }
+
+func (p *EnableMsgsProposal) ProposalRoute() string { return RouterKey }
+
+func (p *EnableMsgsProposal) ProposalType() string { return ProposalTypeEnableMsgs }
+
+func (p *EnableMsgsProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if len(p.MsgTypeUrls) == 0 {
+		return fmt.Errorf("no msg type urls")
+	}
+	return ValidateMsgTypeURLs(p.MsgTypeUrls)
+}
+
+func (p EnableMsgsProposal) String() string {
+	return fmt.Sprintf(`Enable Msgs Proposal:
+  Title:         %s
+  Description:   %s
+  Msg Type URLs: %s
+`, p.Title, p.Description, strings.Join(p.MsgTypeUrls, ", "))
+}
+
+func validateDisableable(msgTypeURLs []string) error {
+	for _, msgTypeURL := range msgTypeURLs {
+		if strings.HasPrefix(msgTypeURL, govMsgPrefix) {
+			return fmt.Errorf("gov msgs can't be disabled: %s", msgTypeURL)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package ante
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/oraichain/orai/app/walker"
+	"github.com/oraichain/orai/x/msglimit/types"
+)
+
+// MsgLimitKeeper defines the expected msglimit keeper.
+type MsgLimitKeeper interface {
+	GetParams(ctx sdk.Context) types.Params
+}
+
+// MsgLimitDecorator rejects txs with too many msgs, top level or wrapped, and
+// charges the extra gas configured for expensive msg types. Wrapped msgs are
+// charged as well, so that wrapping a msg in authz does not avoid the charge.
+type MsgLimitDecorator struct {
+	walker         walker.Walker
+	msgLimitKeeper MsgLimitKeeper
+}
+
+func NewMsgLimitDecorator(msgWalker walker.Walker, msgLimitKeeper MsgLimitKeeper) MsgLimitDecorator {
+	return MsgLimitDecorator{
+		walker:         msgWalker,
+		msgLimitKeeper: msgLimitKeeper,
+	}
+}
+
+func (mld MsgLimitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	params := mld.msgLimitKeeper.GetParams(ctx)
+	msgs := tx.GetMsgs()
+
+	if params.MaxMsgsPerTx > 0 && uint64(len(msgs)) > params.MaxMsgsPerTx {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "too many msgs in tx; got: %d max: %d", len(msgs), params.MaxMsgsPerTx)
+	}
+
+	var innerMsgs uint64
+	err = mld.walker.Walk(msgs, func(msg sdk.Msg, depth int) error {
+		if depth > 0 {
+			innerMsgs++
+			if params.MaxInnerMsgs > 0 && innerMsgs > params.MaxInnerMsgs {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "too many inner msgs in tx; max: %d", params.MaxInnerMsgs)
+			}
+		}
+
+		if charge, found := params.MsgGasCharge(sdk.MsgTypeURL(msg)); found {
+			ctx.GasMeter().ConsumeGas(charge.Gas(uint64(proto.Size(msg))), "msg gas charge")
+		}
+		return nil
+	})
+	if err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
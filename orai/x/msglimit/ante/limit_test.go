@@ -0,0 +1,116 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/oraichain/orai/app/walker"
+	"github.com/oraichain/orai/x/msglimit/ante"
+	"github.com/oraichain/orai/x/msglimit/types"
+)
+
+type mockKeeper struct {
+	params types.Params
+}
+
+func (k mockKeeper) GetParams(_ sdk.Context) types.Params {
+	return k.params
+}
+
+type mockTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx mockTx) GetMsgs() []sdk.Msg   { return tx.msgs }
+func (tx mockTx) ValidateBasic() error { return nil }
+
+func TestMsgLimitDecorator(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	authz.RegisterInterfaces(registry)
+	banktypes.RegisterInterfaces(registry)
+	msgWalker := walker.New(codec.NewProtoCodec(registry), walker.DefaultMaxDepth)
+
+	addr := sdk.AccAddress("addr1_______________")
+	send := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("orai", 1)))
+	sendSize := uint64(proto.Size(send))
+	exec := func(msgs ...sdk.Msg) sdk.Msg {
+		msg := authz.NewMsgExec(addr, msgs)
+		return &msg
+	}
+	sendCharge := types.MsgGasCharge{MsgTypeUrl: sdk.MsgTypeURL(send), BaseGas: 1000, GasPerByte: 10, FreeBytes: 10}
+
+	cases := map[string]struct {
+		params types.Params
+		msgs   []sdk.Msg
+		expGas uint64
+		expErr bool
+	}{
+		"no limits": {
+			params: types.NewParams(0, 0, nil),
+			msgs:   []sdk.Msg{send, send, exec(send, send)},
+		},
+		"msgs at limit": {
+			params: types.NewParams(2, 2, nil),
+			msgs:   []sdk.Msg{send, exec(send, send)},
+		},
+		"too many msgs": {
+			params: types.NewParams(2, 0, nil),
+			msgs:   []sdk.Msg{send, send, send},
+			expErr: true,
+		},
+		"too many inner msgs": {
+			params: types.NewParams(0, 2, nil),
+			msgs:   []sdk.Msg{exec(send), exec(send, send)},
+			expErr: true,
+		},
+		"too many nested inner msgs": {
+			params: types.NewParams(0, 1, nil),
+			msgs:   []sdk.Msg{exec(exec(send))},
+			expErr: true,
+		},
+		"gas charge": {
+			params: types.NewParams(0, 0, []types.MsgGasCharge{sendCharge}),
+			msgs:   []sdk.Msg{send},
+			expGas: 1000 + 10*(sendSize-10),
+		},
+		"gas charge of wrapped msgs": {
+			params: types.NewParams(0, 0, []types.MsgGasCharge{sendCharge}),
+			msgs:   []sdk.Msg{send, exec(send)},
+			expGas: 2 * (1000 + 10*(sendSize-10)),
+		},
+		"msg under free bytes": {
+			params: types.NewParams(0, 0, []types.MsgGasCharge{{MsgTypeUrl: sdk.MsgTypeURL(send), BaseGas: 1000, GasPerByte: 10, FreeBytes: sendSize}}),
+			msgs:   []sdk.Msg{send},
+			expGas: 1000,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.NewContext(store.NewCommitMultiStore(dbm.NewMemDB()), tmproto.Header{}, false, log.NewNopLogger()).
+				WithGasMeter(sdk.NewInfiniteGasMeter())
+
+			decorator := ante.NewMsgLimitDecorator(msgWalker, mockKeeper{params: tc.params})
+			_, err := decorator.AnteHandle(ctx, mockTx{msgs: tc.msgs}, false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+				return ctx, nil
+			})
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expGas, ctx.GasMeter().GasConsumed())
+		})
+	}
+}
@@ -0,0 +1,877 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: orai/msglimit/v1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the msglimit module's genesis state.
+type GenesisState struct {
+	// params defines all the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c710d71bda2e46ea, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// Params defines the msg limits of a tx and the extra gas charged for
+// expensive msgs.
+type Params struct {
+	// max_msgs_per_tx is the number of top level msgs a tx can contain, 0
+	// disables the limit.
+	MaxMsgsPerTx uint64 `protobuf:"varint,1,opt,name=max_msgs_per_tx,json=maxMsgsPerTx,proto3" json:"max_msgs_per_tx,omitempty" yaml:"max_msgs_per_tx"`
+	// max_inner_msgs is the total number of msgs a tx can wrap inside authz
+	// MsgExec or interchain account packets, 0 disables the limit.
+	MaxInnerMsgs uint64 `protobuf:"varint,2,opt,name=max_inner_msgs,json=maxInnerMsgs,proto3" json:"max_inner_msgs,omitempty" yaml:"max_inner_msgs"`
+	// msg_gas_charges lists the extra gas charged per msg type.
+	MsgGasCharges []MsgGasCharge `protobuf:"bytes,3,rep,name=msg_gas_charges,json=msgGasCharges,proto3" json:"msg_gas_charges" yaml:"msg_gas_charges"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c710d71bda2e46ea, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxMsgsPerTx() uint64 {
+	if m != nil {
+		return m.MaxMsgsPerTx
+	}
+	return 0
+}
+
+func (m *Params) GetMaxInnerMsgs() uint64 {
+	if m != nil {
+		return m.MaxInnerMsgs
+	}
+	return 0
+}
+
+func (m *Params) GetMsgGasCharges() []MsgGasCharge {
+	if m != nil {
+		return m.MsgGasCharges
+	}
+	return nil
+}
+
+// MsgGasCharge is the extra gas charged for every msg of a type, on top of the
+// gas used to execute it:
+//
+//	base_gas + gas_per_byte * max(0, size - free_bytes)
+//
+// where size is the encoded size of the msg, e.g. to charge for wasm byte
+// code or large IBC packets.
+type MsgGasCharge struct {
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
+	BaseGas    uint64 `protobuf:"varint,2,opt,name=base_gas,json=baseGas,proto3" json:"base_gas,omitempty" yaml:"base_gas"`
+	GasPerByte uint64 `protobuf:"varint,3,opt,name=gas_per_byte,json=gasPerByte,proto3" json:"gas_per_byte,omitempty" yaml:"gas_per_byte"`
+	FreeBytes  uint64 `protobuf:"varint,4,opt,name=free_bytes,json=freeBytes,proto3" json:"free_bytes,omitempty" yaml:"free_bytes"`
+}
+
+func (m *MsgGasCharge) Reset()         { *m = MsgGasCharge{} }
+func (m *MsgGasCharge) String() string { return proto.CompactTextString(m) }
+func (*MsgGasCharge) ProtoMessage()    {}
+func (*MsgGasCharge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c710d71bda2e46ea, []int{2}
+}
+func (m *MsgGasCharge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGasCharge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGasCharge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGasCharge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGasCharge.Merge(m, src)
+}
+func (m *MsgGasCharge) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGasCharge) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGasCharge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGasCharge proto.InternalMessageInfo
+
+func (m *MsgGasCharge) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgGasCharge) GetBaseGas() uint64 {
+	if m != nil {
+		return m.BaseGas
+	}
+	return 0
+}
+
+func (m *MsgGasCharge) GetGasPerByte() uint64 {
+	if m != nil {
+		return m.GasPerByte
+	}
+	return 0
+}
+
+func (m *MsgGasCharge) GetFreeBytes() uint64 {
+	if m != nil {
+		return m.FreeBytes
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "orai.msglimit.v1.GenesisState")
+	proto.RegisterType((*Params)(nil), "orai.msglimit.v1.Params")
+	proto.RegisterType((*MsgGasCharge)(nil), "orai.msglimit.v1.MsgGasCharge")
+}
+
+func init() { proto.RegisterFile("orai/msglimit/v1/genesis.proto", fileDescriptor_c710d71bda2e46ea) }
+
+var fileDescriptor_c710d71bda2e46ea = []byte{
+	// 450 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0xb1, 0x6f, 0xd3, 0x40,
+	0x14, 0xc6, 0x6d, 0x12, 0x05, 0x7a, 0x0d, 0x04, 0xae, 0x14, 0x4c, 0x87, 0x73, 0x75, 0x53, 0xc5,
+	0x60, 0xab, 0x05, 0x21, 0xd1, 0x05, 0x61, 0x10, 0x11, 0x43, 0xa5, 0xca, 0x94, 0x85, 0xc5, 0xba,
+	0x44, 0xd7, 0x8b, 0xa5, 0x9c, 0x6d, 0xdd, 0xbb, 0x56, 0xf6, 0x7f, 0xc1, 0xc8, 0xc8, 0x9f, 0xd3,
+	0xb1, 0x23, 0x93, 0x85, 0x92, 0x8d, 0xd1, 0x23, 0x13, 0xba, 0x73, 0x23, 0x9b, 0x76, 0x7b, 0xd6,
+	0xef, 0xfb, 0xbe, 0xe7, 0xef, 0xe9, 0x10, 0xc9, 0x15, 0x4b, 0x43, 0x09, 0x62, 0x99, 0xca, 0x54,
+	0x87, 0x97, 0x87, 0xa1, 0xe0, 0x19, 0x87, 0x14, 0x82, 0x42, 0xe5, 0x3a, 0xc7, 0x8f, 0x0d, 0x0f,
+	0x36, 0x3c, 0xb8, 0x3c, 0xdc, 0x7b, 0x2a, 0x72, 0x91, 0x5b, 0x18, 0x9a, 0xa9, 0xd5, 0xd1, 0x4f,
+	0x68, 0x3c, 0x6d, 0x8d, 0x5f, 0x34, 0xd3, 0x1c, 0xbf, 0x41, 0xa3, 0x82, 0x29, 0x26, 0xc1, 0x73,
+	0xf7, 0xdd, 0x83, 0xed, 0x23, 0x2f, 0xb8, 0x1d, 0x14, 0x9c, 0x5a, 0x1e, 0x0d, 0xaf, 0x6a, 0xdf,
+	0x89, 0x6f, 0xd4, 0xf4, 0xaf, 0x8b, 0x46, 0x2d, 0xc0, 0xef, 0xd1, 0x44, 0xb2, 0x32, 0x91, 0x20,
+	0x20, 0x29, 0xb8, 0x4a, 0x74, 0x69, 0xb3, 0x86, 0xd1, 0x5e, 0x53, 0xfb, 0xcf, 0x2a, 0x26, 0x97,
+	0xc7, 0xf4, 0x96, 0x80, 0xc6, 0x63, 0xc9, 0xca, 0x13, 0x10, 0x70, 0xca, 0xd5, 0x59, 0x89, 0xdf,
+	0xa1, 0x47, 0x46, 0x91, 0x66, 0x19, 0x57, 0x56, 0xe7, 0xdd, 0xb3, 0x09, 0x2f, 0x9a, 0xda, 0xdf,
+	0xed, 0x12, 0x3a, 0xde, 0x06, 0x7c, 0x36, 0xdf, 0x26, 0x05, 0x9f, 0xa3, 0x89, 0x04, 0x91, 0x08,
+	0x06, 0xc9, 0x7c, 0xc1, 0x94, 0xe0, 0xe0, 0x0d, 0xf6, 0x07, 0x07, 0xdb, 0x47, 0xe4, 0x6e, 0x9f,
+	0x13, 0x10, 0x53, 0x06, 0x1f, 0xac, 0x2c, 0x22, 0xa6, 0x55, 0xef, 0x3f, 0xff, 0x0f, 0xa1, 0xf1,
+	0x43, 0xd9, 0x53, 0xc3, 0xf1, 0xf0, 0xc7, 0x4f, 0xdf, 0xa1, 0x7f, 0x5c, 0x34, 0xee, 0xa7, 0xe0,
+	0xb7, 0x68, 0x6c, 0x9c, 0xba, 0x2a, 0x78, 0x72, 0xa1, 0x96, 0xb6, 0xff, 0x56, 0xf4, 0xbc, 0xa9,
+	0xfd, 0x9d, 0x2e, 0x77, 0x43, 0x69, 0x8c, 0x24, 0x88, 0xb3, 0xaa, 0xe0, 0x5f, 0xd5, 0x12, 0x07,
+	0xe8, 0xc1, 0x8c, 0x01, 0x37, 0x5b, 0x6f, 0x4a, 0xef, 0x34, 0xb5, 0x3f, 0x69, 0x6d, 0x1b, 0x42,
+	0xe3, 0xfb, 0x66, 0x9c, 0x32, 0x30, 0xab, 0x04, 0x6b, 0xef, 0x38, 0xab, 0x34, 0xf7, 0x06, 0xd6,
+	0xd3, 0x5b, 0xd5, 0xa7, 0x34, 0x46, 0x82, 0x99, 0x1b, 0x47, 0x95, 0xe6, 0xf8, 0x35, 0x42, 0xe7,
+	0x8a, 0x73, 0x4b, 0xc0, 0x1b, 0x5a, 0xe3, 0x6e, 0x53, 0xfb, 0x4f, 0x5a, 0x63, 0xc7, 0x68, 0xbc,
+	0x65, 0x3e, 0x8c, 0x09, 0xa2, 0x8f, 0x57, 0x2b, 0xe2, 0x5e, 0xaf, 0x88, 0xfb, 0x7b, 0x45, 0xdc,
+	0xef, 0x6b, 0xe2, 0x5c, 0xaf, 0x89, 0xf3, 0x6b, 0x4d, 0x9c, 0x6f, 0x2f, 0x45, 0xaa, 0x17, 0x17,
+	0xb3, 0x60, 0x9e, 0xcb, 0xd0, 0x5c, 0x79, 0xbe, 0x60, 0x69, 0x66, 0xa7, 0xb0, 0xec, 0x9e, 0xaa,
+	0xa9, 0x0d, 0xb3, 0x91, 0x7d, 0x7e, 0xaf, 0xfe, 0x0d, 0x00, 0x92, 0x82, 0xf7, 0xc8, 0xc8, 0x02,
+	0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgGasCharges) > 0 {
+		for iNdEx := len(m.MsgGasCharges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgGasCharges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MaxInnerMsgs != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxInnerMsgs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxMsgsPerTx != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxMsgsPerTx))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGasCharge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGasCharge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGasCharge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FreeBytes != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.FreeBytes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasPerByte != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.GasPerByte))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BaseGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BaseGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxMsgsPerTx != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxMsgsPerTx))
+	}
+	if m.MaxInnerMsgs != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxInnerMsgs))
+	}
+	if len(m.MsgGasCharges) > 0 {
+		for _, e := range m.MsgGasCharges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgGasCharge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.BaseGas != 0 {
+		n += 1 + sovGenesis(uint64(m.BaseGas))
+	}
+	if m.GasPerByte != 0 {
+		n += 1 + sovGenesis(uint64(m.GasPerByte))
+	}
+	if m.FreeBytes != 0 {
+		n += 1 + sovGenesis(uint64(m.FreeBytes))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMsgsPerTx", wireType)
+			}
+			m.MaxMsgsPerTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMsgsPerTx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInnerMsgs", wireType)
+			}
+			m.MaxInnerMsgs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxInnerMsgs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgGasCharges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgGasCharges = append(m.MsgGasCharges, MsgGasCharge{})
+			if err := m.MsgGasCharges[len(m.MsgGasCharges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGasCharge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGasCharge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGasCharge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGas", wireType)
+			}
+			m.BaseGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPerByte", wireType)
+			}
+			m.GasPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeBytes", wireType)
+			}
+			m.FreeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FreeBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,115 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"gopkg.in/yaml.v2"
+)
+
+// Default msg limits, generous enough for relayers batching packets.
+const (
+	DefaultMaxMsgsPerTx uint64 = 100
+	DefaultMaxInnerMsgs uint64 = 100
+)
+
+// Parameter store keys
+var (
+	KeyMaxMsgsPerTx  = []byte("MaxMsgsPerTx")
+	KeyMaxInnerMsgs  = []byte("MaxInnerMsgs")
+	KeyMsgGasCharges = []byte("MsgGasCharges")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable the param key table for the msglimit module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(maxMsgsPerTx, maxInnerMsgs uint64, msgGasCharges []MsgGasCharge) Params {
+	return Params{
+		MaxMsgsPerTx:  maxMsgsPerTx,
+		MaxInnerMsgs:  maxInnerMsgs,
+		MsgGasCharges: msgGasCharges,
+	}
+}
+
+// DefaultParams returns default parameters, no extra gas is charged.
+func DefaultParams() Params {
+	return NewParams(DefaultMaxMsgsPerTx, DefaultMaxInnerMsgs, []MsgGasCharge{})
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxMsgsPerTx, &p.MaxMsgsPerTx, validateMsgLimit),
+		paramtypes.NewParamSetPair(KeyMaxInnerMsgs, &p.MaxInnerMsgs, validateMsgLimit),
+		paramtypes.NewParamSetPair(KeyMsgGasCharges, &p.MsgGasCharges, validateMsgGasCharges),
+	}
+}
+
+// Validate performs basic validation.
+func (p Params) Validate() error {
+	if err := validateMsgLimit(p.MaxMsgsPerTx); err != nil {
+		return err
+	}
+	if err := validateMsgLimit(p.MaxInnerMsgs); err != nil {
+		return err
+	}
+	return validateMsgGasCharges(p.MsgGasCharges)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// MsgGasCharge returns the extra gas charge of a msg type.
+func (p Params) MsgGasCharge(msgTypeURL string) (MsgGasCharge, bool) {
+	for _, charge := range p.MsgGasCharges {
+		if charge.MsgTypeUrl == msgTypeURL {
+			return charge, true
+		}
+	}
+	return MsgGasCharge{}, false
+}
+
+// Gas returns the extra gas charged for a msg of the given encoded size.
+func (c MsgGasCharge) Gas(size uint64) uint64 {
+	gas := c.BaseGas
+	if size > c.FreeBytes {
+		gas += c.GasPerByte * (size - c.FreeBytes)
+	}
+	return gas
+}
+
+func validateMsgLimit(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMsgGasCharges(i interface{}) error {
+	v, ok := i.([]MsgGasCharge)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, charge := range v {
+		if len(charge.MsgTypeUrl) == 0 || charge.MsgTypeUrl[0] != '/' {
+			return fmt.Errorf("invalid msg type url: %q", charge.MsgTypeUrl)
+		}
+		if seen[charge.MsgTypeUrl] {
+			return fmt.Errorf("duplicate msg gas charge: %s", charge.MsgTypeUrl)
+		}
+		seen[charge.MsgTypeUrl] = true
+	}
+
+	return nil
+}
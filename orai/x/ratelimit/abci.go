@@ -0,0 +1,12 @@
+package ratelimit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/oraichain/orai/x/ratelimit/keeper"
+)
+
+// BeginBlocker starts a new window for the rate limits whose window is over.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.ResetExpiredRateLimits(ctx)
+}
@@ -0,0 +1,152 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/oraichain/orai/x/ratelimit/types"
+)
+
+// NewCmdSubmitAddRateLimitProposal implements a command handler for
+// submitting a proposal to rate limit a denom on a channel.
+func NewCmdSubmitAddRateLimitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "add-rate-limit [channel-id] [denom] [max-percent-send] [max-percent-recv] [duration-hours]",
+		Args:    cobra.ExactArgs(5),
+		Short:   "Submit a proposal to rate limit the transfers of a denom on a channel",
+		Example: "oraid tx gov submit-proposal add-rate-limit channel-0 orai 10 10 24 --title ... --description ... --deposit 10000000orai",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			quota, err := parseQuota(args[2:])
+			if err != nil {
+				return err
+			}
+			return submitProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewAddRateLimitProposal(title, description, types.NewPath(args[1], args[0]), quota)
+			})
+		},
+	}
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// NewCmdSubmitUpdateRateLimitProposal implements a command handler for
+// submitting a proposal to change the quota of a rate limit.
+func NewCmdSubmitUpdateRateLimitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-rate-limit [channel-id] [denom] [max-percent-send] [max-percent-recv] [duration-hours]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Submit a proposal to change the quota of a rate limit and reset its flow",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			quota, err := parseQuota(args[2:])
+			if err != nil {
+				return err
+			}
+			return submitProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewUpdateRateLimitProposal(title, description, types.NewPath(args[1], args[0]), quota)
+			})
+		},
+	}
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// NewCmdSubmitRemoveRateLimitProposal implements a command handler for
+// submitting a proposal to stop rate limiting a denom on a channel.
+func NewCmdSubmitRemoveRateLimitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-rate-limit [channel-id] [denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to stop rate limiting the transfers of a denom on a channel",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewRemoveRateLimitProposal(title, description, types.NewPath(args[1], args[0]))
+			})
+		},
+	}
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// NewCmdSubmitResetRateLimitProposal implements a command handler for
+// submitting a proposal to reset the flow of a rate limit.
+func NewCmdSubmitResetRateLimitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset-rate-limit [channel-id] [denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to reset the flow of a rate limit",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewResetRateLimitProposal(title, description, types.NewPath(args[1], args[0]))
+			})
+		},
+	}
+	addProposalFlags(cmd)
+	return cmd
+}
+
+func parseQuota(args []string) (types.Quota, error) {
+	maxPercentSend, ok := sdk.NewIntFromString(args[0])
+	if !ok {
+		return types.Quota{}, fmt.Errorf("invalid max percent send: %s", args[0])
+	}
+	maxPercentRecv, ok := sdk.NewIntFromString(args[1])
+	if !ok {
+		return types.Quota{}, fmt.Errorf("invalid max percent recv: %s", args[1])
+	}
+	durationHours, err := strconv.ParseUint(args[2], 10, 64)
+	if err != nil {
+		return types.Quota{}, fmt.Errorf("invalid duration hours: %w", err)
+	}
+	return types.NewQuota(maxPercentSend, maxPercentRecv, durationHours), nil
+}
+
+func submitProposal(cmd *cobra.Command, newContent func(title, description string) govtypes.Content) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return err
+	}
+
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(newContent(title, description), deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+
+	if err = msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+}
@@ -0,0 +1,31 @@
+package ratelimit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/oraichain/orai/x/ratelimit/keeper"
+	"github.com/oraichain/orai/x/ratelimit/types"
+)
+
+// InitGenesis import module genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	data types.GenesisState,
+) {
+	if err := data.Validate(); err != nil {
+		panic(err)
+	}
+
+	for _, rateLimit := range data.RateLimits {
+		k.SetRateLimit(ctx, rateLimit)
+	}
+	for _, packet := range data.PendingSendPackets {
+		k.SetPendingSendPacket(ctx, packet)
+	}
+}
+
+// ExportGenesis export module state
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(k.GetAllRateLimits(ctx), k.GetAllPendingSendPackets(ctx))
+}
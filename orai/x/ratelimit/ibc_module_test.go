@@ -0,0 +1,72 @@
+package ratelimit_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/oraichain/orai/x/ratelimit"
+	"github.com/oraichain/orai/x/ratelimit/keeper"
+	"github.com/oraichain/orai/x/ratelimit/types"
+)
+
+type mockBankKeeper struct{}
+
+func (mockBankKeeper) GetSupply(_ sdk.Context, denom string) sdk.Coin {
+	return sdk.NewInt64Coin(denom, 1000)
+}
+
+type mockChannelKeeper struct{}
+
+func (mockChannelKeeper) GetChannel(_ sdk.Context, _, _ string) (channeltypes.Channel, bool) {
+	return channeltypes.Channel{}, true
+}
+
+// mockApp records the acks passed on by the middleware
+type mockApp struct {
+	porttypes.IBCModule
+	acks [][]byte
+}
+
+func (app *mockApp) OnAcknowledgementPacket(_ sdk.Context, _ channeltypes.Packet, ack []byte, _ sdk.AccAddress) error {
+	app.acks = append(app.acks, ack)
+	return nil
+}
+
+func TestOnAcknowledgementPacket(t *testing.T) {
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	ms := store.NewCommitMultiStore(dbm.NewMemDB())
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+	ctx := sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger())
+
+	k := keeper.NewKeeper(key, simapp.MakeTestEncodingConfig().Marshaler, mockBankKeeper{}, mockChannelKeeper{}, nil)
+	require.NoError(t, k.AddRateLimit(ctx, types.NewPath("orai", "channel-0"), types.NewQuota(sdk.NewInt(10), sdk.NewInt(10), 24)))
+	app := &mockApp{}
+	middleware := ratelimit.NewIBCMiddleware(app, k)
+
+	outflow := func() sdk.Int {
+		rateLimit, _ := k.GetRateLimit(ctx, "orai", "channel-0")
+		return rateLimit.Flow.Outflow
+	}
+	data := transfertypes.NewFungibleTokenPacketData("orai", "100", "sender", "receiver")
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, transfertypes.PortID, "channel-0", transfertypes.PortID, "channel-1", clienttypes.NewHeight(0, 100), 0)
+	require.NoError(t, k.SendRateLimitedPacket(ctx, packet))
+	require.Equal(t, sdk.NewInt(100), outflow())
+
+	// the outflow of a transfer with a non-standard ack is undone, and the ack
+	// is passed on to the wrapped application
+	require.NoError(t, middleware.OnAcknowledgementPacket(ctx, packet, []byte("not an ack"), nil))
+	require.True(t, outflow().IsZero())
+	require.Equal(t, [][]byte{[]byte("not an ack")}, app.acks)
+}
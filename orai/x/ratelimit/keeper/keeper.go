@@ -0,0 +1,224 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/oraichain/orai/x/ratelimit/types"
+)
+
+// Keeper of the ratelimit store
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      codec.BinaryCodec
+
+	bankKeeper    types.BankKeeper
+	channelKeeper types.ChannelKeeper
+	ics4Wrapper   porttypes.ICS4Wrapper
+}
+
+func NewKeeper(
+	storeKey sdk.StoreKey,
+	cdc codec.BinaryCodec,
+	bankKeeper types.BankKeeper,
+	channelKeeper types.ChannelKeeper,
+	ics4Wrapper porttypes.ICS4Wrapper,
+) Keeper {
+	return Keeper{
+		storeKey:      storeKey,
+		cdc:           cdc,
+		bankKeeper:    bankKeeper,
+		channelKeeper: channelKeeper,
+		ics4Wrapper:   ics4Wrapper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// SetRateLimit stores a rate limit.
+func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetRateLimitKey(rateLimit.Path.Denom, rateLimit.Path.ChannelId), k.cdc.MustMarshal(&rateLimit))
+}
+
+// GetRateLimit returns the rate limit of a path.
+func (k Keeper) GetRateLimit(ctx sdk.Context, denom, channelID string) (rateLimit types.RateLimit, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetRateLimitKey(denom, channelID))
+	if bz == nil {
+		return rateLimit, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &rateLimit)
+	return rateLimit, true
+}
+
+// IterateRateLimits iterates over all rate limits until cb returns true.
+func (k Keeper) IterateRateLimits(ctx sdk.Context, cb func(rateLimit types.RateLimit) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitPrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshal(iter.Value(), &rateLimit)
+		if cb(rateLimit) {
+			break
+		}
+	}
+}
+
+// GetAllRateLimits returns all rate limits.
+func (k Keeper) GetAllRateLimits(ctx sdk.Context) []types.RateLimit {
+	rateLimits := []types.RateLimit{}
+	k.IterateRateLimits(ctx, func(rateLimit types.RateLimit) bool {
+		rateLimits = append(rateLimits, rateLimit)
+		return false
+	})
+	return rateLimits
+}
+
+// AddRateLimit rate limits a transfer channel for a denom, starting a window
+// valued at the current supply of the denom.
+func (k Keeper) AddRateLimit(ctx sdk.Context, path types.Path, quota types.Quota) error {
+	if _, found := k.GetRateLimit(ctx, path.Denom, path.ChannelId); found {
+		return sdkerrors.Wrapf(types.ErrRateLimitAlreadyExists, "%s on %s", path.Denom, path.ChannelId)
+	}
+	if _, found := k.channelKeeper.GetChannel(ctx, transfertypes.PortID, path.ChannelId); !found {
+		return sdkerrors.Wrap(types.ErrChannelNotFound, path.ChannelId)
+	}
+
+	flow, err := k.newFlow(ctx, path.Denom)
+	if err != nil {
+		return err
+	}
+
+	k.SetRateLimit(ctx, types.RateLimit{Path: path, Quota: quota, Flow: flow})
+	return nil
+}
+
+// UpdateRateLimit replaces the quota of a rate limit and resets its flow.
+func (k Keeper) UpdateRateLimit(ctx sdk.Context, path types.Path, quota types.Quota) error {
+	if _, found := k.GetRateLimit(ctx, path.Denom, path.ChannelId); !found {
+		return sdkerrors.Wrapf(types.ErrRateLimitNotFound, "%s on %s", path.Denom, path.ChannelId)
+	}
+
+	flow, err := k.newFlow(ctx, path.Denom)
+	if err != nil {
+		return err
+	}
+
+	k.removePendingSendPackets(ctx, path.Denom, path.ChannelId)
+	k.SetRateLimit(ctx, types.RateLimit{Path: path, Quota: quota, Flow: flow})
+	return nil
+}
+
+// RemoveRateLimit stops rate limiting a path.
+func (k Keeper) RemoveRateLimit(ctx sdk.Context, denom, channelID string) error {
+	if _, found := k.GetRateLimit(ctx, denom, channelID); !found {
+		return sdkerrors.Wrapf(types.ErrRateLimitNotFound, "%s on %s", denom, channelID)
+	}
+
+	k.removePendingSendPackets(ctx, denom, channelID)
+	ctx.KVStore(k.storeKey).Delete(types.GetRateLimitKey(denom, channelID))
+	return nil
+}
+
+// ResetRateLimit starts a new window for a rate limit, valued at the current
+// supply of the denom. The packets sent in the previous window no longer
+// count once they fail.
+func (k Keeper) ResetRateLimit(ctx sdk.Context, denom, channelID string) error {
+	rateLimit, found := k.GetRateLimit(ctx, denom, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrRateLimitNotFound, "%s on %s", denom, channelID)
+	}
+
+	flow, err := k.newFlow(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	k.removePendingSendPackets(ctx, denom, channelID)
+	rateLimit.Flow = flow
+	k.SetRateLimit(ctx, rateLimit)
+	return nil
+}
+
+// ResetExpiredRateLimits starts a new window for the rate limits whose
+// window is over.
+func (k Keeper) ResetExpiredRateLimits(ctx sdk.Context) {
+	for _, rateLimit := range k.GetAllRateLimits(ctx) {
+		if ctx.BlockTime().Before(rateLimit.Flow.WindowStart.Add(rateLimit.Quota.Duration())) {
+			continue
+		}
+
+		// a denom without supply keeps its window until it has some again
+		if err := k.ResetRateLimit(ctx, rateLimit.Path.Denom, rateLimit.Path.ChannelId); err != nil {
+			k.Logger(ctx).Error("failed to reset rate limit", "denom", rateLimit.Path.Denom, "channel", rateLimit.Path.ChannelId, "err", err)
+		}
+	}
+}
+
+func (k Keeper) newFlow(ctx sdk.Context, denom string) (types.Flow, error) {
+	channelValue := k.bankKeeper.GetSupply(ctx, denom).Amount
+	if channelValue.IsZero() {
+		return types.Flow{}, sdkerrors.Wrap(types.ErrZeroChannelValue, denom)
+	}
+	return types.NewFlow(channelValue, ctx.BlockTime()), nil
+}
+
+// SetPendingSendPacket records a packet sent in the current window of its
+// path.
+func (k Keeper) SetPendingSendPacket(ctx sdk.Context, packet types.PendingSendPacket) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPendingSendPacketKey(packet.Denom, packet.ChannelId, packet.Sequence), k.cdc.MustMarshal(&packet))
+}
+
+// HasPendingSendPacket returns true if a packet was sent in the current
+// window of its path and has not been acknowledged yet.
+func (k Keeper) HasPendingSendPacket(ctx sdk.Context, denom, channelID string, sequence uint64) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetPendingSendPacketKey(denom, channelID, sequence))
+}
+
+// RemovePendingSendPacket forgets a pending send packet.
+func (k Keeper) RemovePendingSendPacket(ctx sdk.Context, denom, channelID string, sequence uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.GetPendingSendPacketKey(denom, channelID, sequence))
+}
+
+func (k Keeper) removePendingSendPackets(ctx sdk.Context, denom, channelID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetPendingSendPacketsKey(denom, channelID))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetAllPendingSendPackets returns all pending send packets.
+func (k Keeper) GetAllPendingSendPackets(ctx sdk.Context) []types.PendingSendPacket {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSendPacketPrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	packets := []types.PendingSendPacket{}
+	for ; iter.Valid(); iter.Next() {
+		var packet types.PendingSendPacket
+		k.cdc.MustUnmarshal(iter.Value(), &packet)
+		packets = append(packets, packet)
+	}
+	return packets
+}
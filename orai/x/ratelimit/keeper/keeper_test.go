@@ -0,0 +1,153 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/oraichain/orai/x/ratelimit/keeper"
+	"github.com/oraichain/orai/x/ratelimit/types"
+)
+
+const (
+	denom     = "orai"
+	channelID = "channel-0"
+)
+
+type mockBankKeeper struct {
+	supply map[string]int64
+}
+
+func (k mockBankKeeper) GetSupply(_ sdk.Context, denom string) sdk.Coin {
+	return sdk.NewInt64Coin(denom, k.supply[denom])
+}
+
+type mockChannelKeeper struct{}
+
+func (mockChannelKeeper) GetChannel(_ sdk.Context, _, _ string) (channeltypes.Channel, bool) {
+	return channeltypes.Channel{}, true
+}
+
+func setupKeeper(t *testing.T, bankKeeper mockBankKeeper) (sdk.Context, keeper.Keeper) {
+	key := sdk.NewKVStoreKey(types.StoreKey)
+
+	ms := store.NewCommitMultiStore(dbm.NewMemDB())
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	encCfg := simapp.MakeTestEncodingConfig()
+	k := keeper.NewKeeper(key, encCfg.Marshaler, bankKeeper, mockChannelKeeper{}, nil)
+
+	ctx := sdk.NewContext(ms, tmproto.Header{Time: time.Unix(1_700_000_000, 0).UTC()}, false, log.NewNopLogger())
+	return ctx, k
+}
+
+// sendPacket returns a transfer of the amount of orai sent on channel-0
+func sendPacket(sequence uint64, amount string) channeltypes.Packet {
+	data := transfertypes.NewFungibleTokenPacketData(denom, amount, "sender", "receiver")
+	return channeltypes.NewPacket(data.GetBytes(), sequence, transfertypes.PortID, channelID, transfertypes.PortID, "channel-1", clienttypes.NewHeight(0, 100), 0)
+}
+
+// recvPacket returns a transfer of the amount of orai returning on channel-0
+func recvPacket(sequence uint64, amount string) channeltypes.Packet {
+	data := transfertypes.NewFungibleTokenPacketData("transfer/channel-1/"+denom, amount, "sender", "receiver")
+	return channeltypes.NewPacket(data.GetBytes(), sequence, transfertypes.PortID, "channel-1", transfertypes.PortID, channelID, clienttypes.NewHeight(0, 100), 0)
+}
+
+func getFlow(t *testing.T, ctx sdk.Context, k keeper.Keeper) types.Flow {
+	rateLimit, found := k.GetRateLimit(ctx, denom, channelID)
+	require.True(t, found)
+	return rateLimit.Flow
+}
+
+func TestQuotaBoundary(t *testing.T) {
+	ctx, k := setupKeeper(t, mockBankKeeper{supply: map[string]int64{denom: 1000}})
+	require.NoError(t, k.AddRateLimit(ctx, types.NewPath(denom, channelID), types.NewQuota(sdk.NewInt(10), sdk.NewInt(20), 24)))
+
+	// the net outflow may reach 10% of the channel value, but not exceed it
+	require.NoError(t, k.SendRateLimitedPacket(ctx, sendPacket(1, "60")))
+	require.NoError(t, k.SendRateLimitedPacket(ctx, sendPacket(2, "40")))
+	require.ErrorIs(t, k.SendRateLimitedPacket(ctx, sendPacket(3, "1")), types.ErrQuotaExceeded)
+	require.Equal(t, sdk.NewInt(100), getFlow(t, ctx, k).Outflow)
+	require.False(t, k.HasPendingSendPacket(ctx, denom, channelID, 3))
+
+	// the inflow is netted against the outflow
+	require.NoError(t, k.ReceiveRateLimitedPacket(ctx, recvPacket(1, "300")))
+	require.ErrorIs(t, k.ReceiveRateLimitedPacket(ctx, recvPacket(2, "1")), types.ErrQuotaExceeded)
+	require.NoError(t, k.SendRateLimitedPacket(ctx, sendPacket(3, "300")))
+	require.ErrorIs(t, k.SendRateLimitedPacket(ctx, sendPacket(4, "1")), types.ErrQuotaExceeded)
+
+	flow := getFlow(t, ctx, k)
+	require.Equal(t, sdk.NewInt(300), flow.Inflow)
+	require.Equal(t, sdk.NewInt(400), flow.Outflow)
+
+	// transfers of other denoms are not limited
+	data := transfertypes.NewFungibleTokenPacketData("uatom", "1000000", "sender", "receiver")
+	packet := channeltypes.NewPacket(data.GetBytes(), 4, transfertypes.PortID, channelID, transfertypes.PortID, "channel-1", clienttypes.NewHeight(0, 100), 0)
+	require.NoError(t, k.SendRateLimitedPacket(ctx, packet))
+}
+
+func TestResetExpiredRateLimits(t *testing.T) {
+	bankKeeper := mockBankKeeper{supply: map[string]int64{denom: 1000}}
+	ctx, k := setupKeeper(t, bankKeeper)
+	require.NoError(t, k.AddRateLimit(ctx, types.NewPath(denom, channelID), types.NewQuota(sdk.NewInt(10), sdk.NewInt(10), 24)))
+	require.NoError(t, k.SendRateLimitedPacket(ctx, sendPacket(1, "100")))
+	start := ctx.BlockTime()
+
+	// the window is kept until its duration elapses
+	ctx = ctx.WithBlockTime(start.Add(24*time.Hour - time.Second))
+	k.ResetExpiredRateLimits(ctx)
+	require.Equal(t, sdk.NewInt(100), getFlow(t, ctx, k).Outflow)
+	require.ErrorIs(t, k.SendRateLimitedPacket(ctx, sendPacket(2, "1")), types.ErrQuotaExceeded)
+
+	// the new window is valued at the current supply
+	bankKeeper.supply[denom] = 2000
+	ctx = ctx.WithBlockTime(start.Add(24 * time.Hour))
+	k.ResetExpiredRateLimits(ctx)
+	flow := getFlow(t, ctx, k)
+	require.Equal(t, types.NewFlow(sdk.NewInt(2000), ctx.BlockTime()), flow)
+	require.False(t, k.HasPendingSendPacket(ctx, denom, channelID, 1))
+	require.NoError(t, k.SendRateLimitedPacket(ctx, sendPacket(2, "200")))
+
+	// a denom without supply keeps its window
+	bankKeeper.supply[denom] = 0
+	ctx = ctx.WithBlockTime(start.Add(48 * time.Hour))
+	k.ResetExpiredRateLimits(ctx)
+	require.Equal(t, sdk.NewInt(200), getFlow(t, ctx, k).Outflow)
+}
+
+func TestCompleteRateLimitedPacket(t *testing.T) {
+	ctx, k := setupKeeper(t, mockBankKeeper{supply: map[string]int64{denom: 1000}})
+	require.NoError(t, k.AddRateLimit(ctx, types.NewPath(denom, channelID), types.NewQuota(sdk.NewInt(10), sdk.NewInt(10), 24)))
+	require.NoError(t, k.SendRateLimitedPacket(ctx, sendPacket(1, "60")))
+	require.NoError(t, k.SendRateLimitedPacket(ctx, sendPacket(2, "40")))
+
+	// the outflow of a failed send is undone, once
+	require.NoError(t, k.CompleteRateLimitedPacket(ctx, sendPacket(1, "60"), false))
+	require.Equal(t, sdk.NewInt(40), getFlow(t, ctx, k).Outflow)
+	require.False(t, k.HasPendingSendPacket(ctx, denom, channelID, 1))
+	require.NoError(t, k.CompleteRateLimitedPacket(ctx, sendPacket(1, "60"), false))
+	require.Equal(t, sdk.NewInt(40), getFlow(t, ctx, k).Outflow)
+
+	// the outflow of a successful send is kept
+	require.NoError(t, k.CompleteRateLimitedPacket(ctx, sendPacket(2, "40"), true))
+	require.Equal(t, sdk.NewInt(40), getFlow(t, ctx, k).Outflow)
+	require.False(t, k.HasPendingSendPacket(ctx, denom, channelID, 2))
+
+	// a send of the previous window does not reduce the outflow of the new one
+	require.NoError(t, k.SendRateLimitedPacket(ctx, sendPacket(3, "50")))
+	require.NoError(t, k.ResetRateLimit(ctx, denom, channelID))
+	require.NoError(t, k.SendRateLimitedPacket(ctx, sendPacket(4, "30")))
+	require.NoError(t, k.CompleteRateLimitedPacket(ctx, sendPacket(3, "50"), false))
+	require.Equal(t, sdk.NewInt(30), getFlow(t, ctx, k).Outflow)
+}
@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+
+	"github.com/oraichain/orai/x/ratelimit/types"
+)
+
+var _ porttypes.ICS4Wrapper = Keeper{}
+
+// transfer is an ics20 transfer seen from this chain.
+type transfer struct {
+	denom     string
+	channelID string
+	amount    sdk.Int
+}
+
+// parseTransfer returns the transfer of a packet, false if it is not an
+// ics20 packet. The denom is the one of the tokens on this chain and the
+// channel is the one of this chain.
+func parseTransfer(packet ibcexported.PacketI, send bool) (transfer, bool, error) {
+	port := packet.GetDestPort()
+	if send {
+		port = packet.GetSourcePort()
+	}
+	if port != transfertypes.PortID {
+		return transfer{}, false, nil
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return transfer{}, false, nil
+	}
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return transfer{}, false, sdkerrors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount %s", data.Amount)
+	}
+
+	if send {
+		return transfer{
+			denom:     transfertypes.ParseDenomTrace(data.Denom).IBCDenom(),
+			channelID: packet.GetSourceChannel(),
+			amount:    amount,
+		}, true, nil
+	}
+
+	// the tokens received are either returning to this chain, unprefixed, or
+	// vouchers of the counterparty, prefixed with the channel of this chain
+	var denom string
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		denom = data.Denom[len(transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())):]
+	} else {
+		denom = transfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel()) + data.Denom
+	}
+	return transfer{
+		denom:     transfertypes.ParseDenomTrace(denom).IBCDenom(),
+		channelID: packet.GetDestChannel(),
+		amount:    amount,
+	}, true, nil
+}
+
+// SendRateLimitedPacket adds a sent ics20 packet to the outflow of its path,
+// unless it exceeds the quota.
+func (k Keeper) SendRateLimitedPacket(ctx sdk.Context, packet ibcexported.PacketI) error {
+	t, ok, err := parseTransfer(packet, true)
+	if err != nil || !ok {
+		return err
+	}
+	rateLimit, found := k.GetRateLimit(ctx, t.denom, t.channelID)
+	if !found {
+		return nil
+	}
+
+	if err := rateLimit.Flow.AddOutflow(t.amount, rateLimit.Quota); err != nil {
+		k.emitQuotaExceeded(ctx, t, types.AttributeValueSend)
+		return err
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+	k.SetPendingSendPacket(ctx, types.PendingSendPacket{ChannelId: t.channelID, Denom: t.denom, Sequence: packet.GetSequence()})
+	return nil
+}
+
+// ReceiveRateLimitedPacket adds a received ics20 packet to the inflow of its
+// path, unless it exceeds the quota.
+func (k Keeper) ReceiveRateLimitedPacket(ctx sdk.Context, packet ibcexported.PacketI) error {
+	t, ok, err := parseTransfer(packet, false)
+	if err != nil || !ok {
+		return err
+	}
+	rateLimit, found := k.GetRateLimit(ctx, t.denom, t.channelID)
+	if !found {
+		return nil
+	}
+
+	if err := rateLimit.Flow.AddInflow(t.amount, rateLimit.Quota); err != nil {
+		k.emitQuotaExceeded(ctx, t, types.AttributeValueRecv)
+		return err
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+	return nil
+}
+
+// CompleteRateLimitedPacket forgets a sent ics20 packet once it is
+// acknowledged or timed out. The outflow of a failed packet is undone, if
+// it was sent in the current window of its path.
+func (k Keeper) CompleteRateLimitedPacket(ctx sdk.Context, packet ibcexported.PacketI, success bool) error {
+	t, ok, err := parseTransfer(packet, true)
+	if err != nil || !ok {
+		return err
+	}
+	if !k.HasPendingSendPacket(ctx, t.denom, t.channelID, packet.GetSequence()) {
+		return nil
+	}
+	k.RemovePendingSendPacket(ctx, t.denom, t.channelID, packet.GetSequence())
+
+	rateLimit, found := k.GetRateLimit(ctx, t.denom, t.channelID)
+	if success || !found {
+		return nil
+	}
+
+	rateLimit.Flow.Outflow = sdk.MaxInt(rateLimit.Flow.Outflow.Sub(t.amount), sdk.ZeroInt())
+	k.SetRateLimit(ctx, rateLimit)
+	return nil
+}
+
+func (k Keeper) emitQuotaExceeded(ctx sdk.Context, t transfer, direction string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeQuotaExceeded,
+			sdk.NewAttribute(types.AttributeKeyDenom, t.denom),
+			sdk.NewAttribute(types.AttributeKeyChannelID, t.channelID),
+			sdk.NewAttribute(types.AttributeKeyDirection, direction),
+			sdk.NewAttribute(types.AttributeKeyAmount, t.amount.String()),
+		),
+	)
+}
+
+// SendPacket implements the ICS4Wrapper interface. Transfers exceeding the
+// quota of their path are rejected.
+func (k Keeper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI) error {
+	if err := k.SendRateLimitedPacket(ctx, packet); err != nil {
+		return err
+	}
+	return k.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface.
+func (k Keeper) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, ack ibcexported.Acknowledgement) error {
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// GetAppVersion implements the ICS4Wrapper interface.
+func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/oraichain/orai/x/ratelimit/types"
+)
+
+// NewProposalHandler returns the gov handler of the ratelimit proposals.
+func NewProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.AddRateLimitProposal:
+			return k.HandleAddRateLimitProposal(ctx, c)
+		case *types.UpdateRateLimitProposal:
+			return k.HandleUpdateRateLimitProposal(ctx, c)
+		case *types.RemoveRateLimitProposal:
+			return k.HandleRemoveRateLimitProposal(ctx, c)
+		case *types.ResetRateLimitProposal:
+			return k.HandleResetRateLimitProposal(ctx, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized ratelimit proposal content type: %T", c)
+		}
+	}
+}
+
+func (k Keeper) HandleAddRateLimitProposal(ctx sdk.Context, p *types.AddRateLimitProposal) error {
+	if err := k.AddRateLimit(ctx, p.Path, p.Quota); err != nil {
+		return err
+	}
+	emitPathEvent(ctx, types.EventTypeAddRateLimit, p.Path)
+	return nil
+}
+
+func (k Keeper) HandleUpdateRateLimitProposal(ctx sdk.Context, p *types.UpdateRateLimitProposal) error {
+	if err := k.UpdateRateLimit(ctx, p.Path, p.Quota); err != nil {
+		return err
+	}
+	emitPathEvent(ctx, types.EventTypeUpdateRateLimit, p.Path)
+	return nil
+}
+
+func (k Keeper) HandleRemoveRateLimitProposal(ctx sdk.Context, p *types.RemoveRateLimitProposal) error {
+	if err := k.RemoveRateLimit(ctx, p.Path.Denom, p.Path.ChannelId); err != nil {
+		return err
+	}
+	emitPathEvent(ctx, types.EventTypeRemoveRateLimit, p.Path)
+	return nil
+}
+
+func (k Keeper) HandleResetRateLimitProposal(ctx sdk.Context, p *types.ResetRateLimitProposal) error {
+	if err := k.ResetRateLimit(ctx, p.Path.Denom, p.Path.ChannelId); err != nil {
+		return err
+	}
+	emitPathEvent(ctx, types.EventTypeResetRateLimit, p.Path)
+	return nil
+}
+
+func emitPathEvent(ctx sdk.Context, eventType string, path types.Path) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyDenom, path.Denom),
+			sdk.NewAttribute(types.AttributeKeyChannelID, path.ChannelId),
+		),
+	)
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/oraichain/orai/x/ratelimit/types"
+)
+
+var _ types.QueryServer = &Querier{}
+
+type Querier struct {
+	keeper Keeper
+}
+
+func NewQuerier(k Keeper) Querier {
+	return Querier{
+		keeper: k,
+	}
+}
+
+// RateLimits returns all rate limits.
+func (q Querier) RateLimits(stdCtx context.Context, _ *types.QueryRateLimitsRequest) (*types.QueryRateLimitsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+
+	return &types.QueryRateLimitsResponse{
+		RateLimits: q.keeper.GetAllRateLimits(ctx),
+	}, nil
+}
+
+// RateLimit returns the rate limit of a path.
+func (q Querier) RateLimit(stdCtx context.Context, req *types.QueryRateLimitRequest) (*types.QueryRateLimitResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+
+	rateLimit, found := q.keeper.GetRateLimit(ctx, req.Denom, req.ChannelId)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrRateLimitNotFound, "%s on %s", req.Denom, req.ChannelId)
+	}
+
+	return &types.QueryRateLimitResponse{
+		RateLimit: rateLimit,
+	}, nil
+}
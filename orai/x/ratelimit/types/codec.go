@@ -0,0 +1,25 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// RegisterLegacyAminoCodec registers concrete types on the LegacyAmino codec
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&AddRateLimitProposal{}, "ratelimit/AddRateLimitProposal", nil)
+	cdc.RegisterConcrete(&UpdateRateLimitProposal{}, "ratelimit/UpdateRateLimitProposal", nil)
+	cdc.RegisterConcrete(&RemoveRateLimitProposal{}, "ratelimit/RemoveRateLimitProposal", nil)
+	cdc.RegisterConcrete(&ResetRateLimitProposal{}, "ratelimit/ResetRateLimitProposal", nil)
+}
+
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&AddRateLimitProposal{},
+		&UpdateRateLimitProposal{},
+		&RemoveRateLimitProposal{},
+		&ResetRateLimitProposal{},
+	)
+}
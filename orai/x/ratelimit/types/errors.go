@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/ratelimit module sentinel errors
+var (
+	ErrRateLimitNotFound      = sdkerrors.Register(ModuleName, 2, "rate limit not found")
+	ErrRateLimitAlreadyExists = sdkerrors.Register(ModuleName, 3, "rate limit already exists")
+	ErrQuotaExceeded          = sdkerrors.Register(ModuleName, 4, "quota exceeded")
+	ErrZeroChannelValue       = sdkerrors.Register(ModuleName, 5, "channel value is zero")
+	ErrChannelNotFound        = sdkerrors.Register(ModuleName, 6, "channel not found")
+)
@@ -0,0 +1,18 @@
+package types
+
+// ratelimit module event types
+const (
+	EventTypeAddRateLimit    = "add_rate_limit"
+	EventTypeUpdateRateLimit = "update_rate_limit"
+	EventTypeRemoveRateLimit = "remove_rate_limit"
+	EventTypeResetRateLimit  = "reset_rate_limit"
+	EventTypeQuotaExceeded   = "quota_exceeded"
+
+	AttributeKeyDenom     = "denom"
+	AttributeKeyChannelID = "channel_id"
+	AttributeKeyDirection = "direction"
+	AttributeKeyAmount    = "amount"
+
+	AttributeValueSend = "send"
+	AttributeValueRecv = "recv"
+)
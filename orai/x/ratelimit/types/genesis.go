@@ -0,0 +1,38 @@
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(rateLimits []RateLimit, pendingSendPackets []PendingSendPacket) *GenesisState {
+	return &GenesisState{
+		RateLimits:         rateLimits,
+		PendingSendPackets: pendingSendPackets,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state, no path is rate
+// limited.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState([]RateLimit{}, []PendingSendPacket{})
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	seen := make(map[Path]bool, len(gs.RateLimits))
+	for _, rateLimit := range gs.RateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+		if seen[rateLimit.Path] {
+			return fmt.Errorf("duplicate rate limit of %s on %s", rateLimit.Path.Denom, rateLimit.Path.ChannelId)
+		}
+		seen[rateLimit.Path] = true
+	}
+
+	for _, packet := range gs.PendingSendPackets {
+		if !seen[NewPath(packet.Denom, packet.ChannelId)] {
+			return fmt.Errorf("pending send packet %d of %s on %s has no rate limit", packet.Sequence, packet.Denom, packet.ChannelId)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "ratelimit"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+var (
+	// RateLimitPrefix prefixes the rate limits by channel and denom.
+	RateLimitPrefix = []byte{0x01}
+	// PendingSendPacketPrefix prefixes the pending send packets by channel,
+	// denom and sequence.
+	PendingSendPacketPrefix = []byte{0x02}
+)
+
+// GetRateLimitKey returns the store key of the rate limit of a path.
+func GetRateLimitKey(denom, channelID string) []byte {
+	return append(append(RateLimitPrefix, address.MustLengthPrefix([]byte(channelID))...), []byte(denom)...)
+}
+
+// GetPendingSendPacketsKey returns the prefix of the pending send packets of
+// a path.
+func GetPendingSendPacketsKey(denom, channelID string) []byte {
+	key := append(PendingSendPacketPrefix, address.MustLengthPrefix([]byte(channelID))...)
+	return append(key, address.MustLengthPrefix([]byte(denom))...)
+}
+
+// GetPendingSendPacketKey returns the store key of a pending send packet.
+func GetPendingSendPacketKey(denom, channelID string, sequence uint64) []byte {
+	return append(GetPendingSendPacketsKey(denom, channelID), sdk.Uint64ToBigEndian(sequence)...)
+}
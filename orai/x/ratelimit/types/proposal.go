@@ -0,0 +1,178 @@
+package types
+
+import (
+	"fmt"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeAddRateLimit    = "AddRateLimit"
+	ProposalTypeUpdateRateLimit = "UpdateRateLimit"
+	ProposalTypeRemoveRateLimit = "RemoveRateLimit"
+	ProposalTypeResetRateLimit  = "ResetRateLimit"
+)
+
+var (
+	_ govtypes.Content = &AddRateLimitProposal{}
+	_ govtypes.Content = &UpdateRateLimitProposal{}
+	_ govtypes.Content = &RemoveRateLimitProposal{}
+	_ govtypes.Content = &ResetRateLimitProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeAddRateLimit)
+	govtypes.RegisterProposalTypeCodec(&AddRateLimitProposal{}, "ratelimit/AddRateLimitProposal")
+	govtypes.RegisterProposalType(ProposalTypeUpdateRateLimit)
+	govtypes.RegisterProposalTypeCodec(&UpdateRateLimitProposal{}, "ratelimit/UpdateRateLimitProposal")
+	govtypes.RegisterProposalType(ProposalTypeRemoveRateLimit)
+	govtypes.RegisterProposalTypeCodec(&RemoveRateLimitProposal{}, "ratelimit/RemoveRateLimitProposal")
+	govtypes.RegisterProposalType(ProposalTypeResetRateLimit)
+	govtypes.RegisterProposalTypeCodec(&ResetRateLimitProposal{}, "ratelimit/ResetRateLimitProposal")
+}
+
+// NewAddRateLimitProposal creates a new AddRateLimitProposal instance.
+func NewAddRateLimitProposal(title, description string, path Path, quota Quota) *AddRateLimitProposal {
+	return &AddRateLimitProposal{
+		Title:       title,
+		Description: description,
+		Path:        path,
+		Quota:       quota,
+	}
+}
+
+func (p *AddRateLimitProposal) GetTitle() string { return p.Title }
+
+func (p *AddRateLimitProposal) GetDescription() string { return p.Description }
+
+func (p *AddRateLimitProposal) ProposalRoute() string { return RouterKey }
+
+func (p *AddRateLimitProposal) ProposalType() string { return ProposalTypeAddRateLimit }
+
+func (p *AddRateLimitProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if err := p.Path.Validate(); err != nil {
+		return err
+	}
+	return p.Quota.Validate()
+}
+
+func (p AddRateLimitProposal) String() string {
+	return fmt.Sprintf(`Add Rate Limit Proposal:
+  Title:            %s
+  Description:      %s
+  Denom:            %s
+  Channel ID:       %s
+  Max Percent Send: %s
+  Max Percent Recv: %s
+  Duration Hours:   %d
+`, p.Title, p.Description, p.Path.Denom, p.Path.ChannelId, p.Quota.MaxPercentSend, p.Quota.MaxPercentRecv, p.Quota.DurationHours)
+}
+
+// NewUpdateRateLimitProposal creates a new UpdateRateLimitProposal instance.
+func NewUpdateRateLimitProposal(title, description string, path Path, quota Quota) *UpdateRateLimitProposal {
+	return &UpdateRateLimitProposal{
+		Title:       title,
+		Description: description,
+		Path:        path,
+		Quota:       quota,
+	}
+}
+
+func (p *UpdateRateLimitProposal) GetTitle() string { return p.Title }
+
+func (p *UpdateRateLimitProposal) GetDescription() string { return p.Description }
+
+func (p *UpdateRateLimitProposal) ProposalRoute() string { return RouterKey }
+
+func (p *UpdateRateLimitProposal) ProposalType() string { return ProposalTypeUpdateRateLimit }
+
+func (p *UpdateRateLimitProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if err := p.Path.Validate(); err != nil {
+		return err
+	}
+	return p.Quota.Validate()
+}
+
+func (p UpdateRateLimitProposal) String() string {
+	return fmt.Sprintf(`Update Rate Limit Proposal:
+  Title:            %s
+  Description:      %s
+  Denom:            %s
+  Channel ID:       %s
+  Max Percent Send: %s
+  Max Percent Recv: %s
+  Duration Hours:   %d
+`, p.Title, p.Description, p.Path.Denom, p.Path.ChannelId, p.Quota.MaxPercentSend, p.Quota.MaxPercentRecv, p.Quota.DurationHours)
+}
+
+// NewRemoveRateLimitProposal creates a new RemoveRateLimitProposal instance.
+func NewRemoveRateLimitProposal(title, description string, path Path) *RemoveRateLimitProposal {
+	return &RemoveRateLimitProposal{
+		Title:       title,
+		Description: description,
+		Path:        path,
+	}
+}
+
+func (p *RemoveRateLimitProposal) GetTitle() string { return p.Title }
+
+func (p *RemoveRateLimitProposal) GetDescription() string { return p.Description }
+
+func (p *RemoveRateLimitProposal) ProposalRoute() string { return RouterKey }
+
+func (p *RemoveRateLimitProposal) ProposalType() string { return ProposalTypeRemoveRateLimit }
+
+func (p *RemoveRateLimitProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	return p.Path.Validate()
+}
+
+func (p RemoveRateLimitProposal) String() string {
+	return fmt.Sprintf(`Remove Rate Limit Proposal:
+  Title:       %s
+  Description: %s
+  Denom:       %s
+  Channel ID:  %s
+`, p.Title, p.Description, p.Path.Denom, p.Path.ChannelId)
+}
+
+// NewResetRateLimitProposal creates a new ResetRateLimitProposal instance.
+func NewResetRateLimitProposal(title, description string, path Path) *ResetRateLimitProposal {
+	return &ResetRateLimitProposal{
+		Title:       title,
+		Description: description,
+		Path:        path,
+	}
+}
+
+func (p *ResetRateLimitProposal) GetTitle() string { return p.Title }
+
+func (p *ResetRateLimitProposal) GetDescription() string { return p.Description }
+
+func (p *ResetRateLimitProposal) ProposalRoute() string { return RouterKey }
+
+func (p *ResetRateLimitProposal) ProposalType() string { return ProposalTypeResetRateLimit }
+
+func (p *ResetRateLimitProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	return p.Path.Validate()
+}
+
+func (p ResetRateLimitProposal) String() string {
+	return fmt.Sprintf(`Reset Rate Limit Proposal:
+  Title:       %s
+  Description: %s
+  Denom:       %s
+  Channel ID:  %s
+`, p.Title, p.Description, p.Path.Denom, p.Path.ChannelId)
+}
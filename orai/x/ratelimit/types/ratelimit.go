@@ -0,0 +1,110 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+)
+
+// NewPath creates a new Path instance.
+func NewPath(denom, channelID string) Path {
+	return Path{
+		Denom:     denom,
+		ChannelId: channelID,
+	}
+}
+
+// Validate checks that the path names a valid denom and channel.
+func (p Path) Validate() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return err
+	}
+	return host.ChannelIdentifierValidator(p.ChannelId)
+}
+
+// NewQuota creates a new Quota instance.
+func NewQuota(maxPercentSend, maxPercentRecv sdk.Int, durationHours uint64) Quota {
+	return Quota{
+		MaxPercentSend: maxPercentSend,
+		MaxPercentRecv: maxPercentRecv,
+		DurationHours:  durationHours,
+	}
+}
+
+// Validate checks that the percentages are within [0, 100], not both zero,
+// and that the window is not empty.
+func (q Quota) Validate() error {
+	for _, percent := range []sdk.Int{q.MaxPercentSend, q.MaxPercentRecv} {
+		if percent.IsNil() || percent.IsNegative() || percent.GT(sdk.NewInt(100)) {
+			return fmt.Errorf("max percent must be between 0 and 100: %s", percent)
+		}
+	}
+	if q.MaxPercentSend.IsZero() && q.MaxPercentRecv.IsZero() {
+		return fmt.Errorf("max percent send and recv can't both be zero")
+	}
+	if q.DurationHours == 0 {
+		return fmt.Errorf("duration hours must be positive")
+	}
+	return nil
+}
+
+// Duration returns the length of the window.
+func (q Quota) Duration() time.Duration {
+	return time.Duration(q.DurationHours) * time.Hour
+}
+
+// NewFlow starts a window with the given channel value.
+func NewFlow(channelValue sdk.Int, windowStart time.Time) Flow {
+	return Flow{
+		Inflow:       sdk.ZeroInt(),
+		Outflow:      sdk.ZeroInt(),
+		ChannelValue: channelValue,
+		WindowStart:  windowStart,
+	}
+}
+
+// AddOutflow adds the amount to the outflow, unless the net outflow then
+// exceeds the quota.
+func (f *Flow) AddOutflow(amount sdk.Int, quota Quota) error {
+	outflow := f.Outflow.Add(amount)
+	threshold := f.ChannelValue.Mul(quota.MaxPercentSend).QuoRaw(100)
+	if outflow.Sub(f.Inflow).GT(threshold) {
+		return sdkerrors.Wrapf(ErrQuotaExceeded, "net outflow %s exceeds %s", outflow.Sub(f.Inflow), threshold)
+	}
+
+	f.Outflow = outflow
+	return nil
+}
+
+// AddInflow adds the amount to the inflow, unless the net inflow then
+// exceeds the quota.
+func (f *Flow) AddInflow(amount sdk.Int, quota Quota) error {
+	inflow := f.Inflow.Add(amount)
+	threshold := f.ChannelValue.Mul(quota.MaxPercentRecv).QuoRaw(100)
+	if inflow.Sub(f.Outflow).GT(threshold) {
+		return sdkerrors.Wrapf(ErrQuotaExceeded, "net inflow %s exceeds %s", inflow.Sub(f.Outflow), threshold)
+	}
+
+	f.Inflow = inflow
+	return nil
+}
+
+// Validate checks the path and quota of the rate limit and that its flow is
+// not negative.
+func (r RateLimit) Validate() error {
+	if err := r.Path.Validate(); err != nil {
+		return err
+	}
+	if err := r.Quota.Validate(); err != nil {
+		return err
+	}
+	for _, amount := range []sdk.Int{r.Flow.Inflow, r.Flow.Outflow, r.Flow.ChannelValue} {
+		if amount.IsNil() || amount.IsNegative() {
+			return fmt.Errorf("invalid flow of %s on %s: %s", r.Path.Denom, r.Path.ChannelId, amount)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package ante
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/oraichain/orai/x/sponsor/types"
+)
+
+// SponsorKeeper defines the expected sponsor keeper.
+type SponsorKeeper interface {
+	SponsoredContract(ctx sdk.Context, msgs []sdk.Msg) (sdk.AccAddress, bool)
+	UseSponsoredFees(ctx sdk.Context, contract, user sdk.AccAddress, fee sdk.Coins) error
+}
+
+// DeductFeeDecorator deducts the fees of a tx from the contract it executes
+// when the contract sponsors it, and falls back to ante.DeductFeeDecorator
+// otherwise. A tx is sponsored only if it has no fee granter and all of its
+// msgs execute the same sponsoring contract.
+type DeductFeeDecorator struct {
+	ak            ante.AccountKeeper
+	bankKeeper    authtypes.BankKeeper
+	sponsorKeeper SponsorKeeper
+
+	fallback ante.DeductFeeDecorator
+}
+
+func NewDeductFeeDecorator(ak ante.AccountKeeper, bk authtypes.BankKeeper, fk ante.FeegrantKeeper, sk SponsorKeeper) DeductFeeDecorator {
+	return DeductFeeDecorator{
+		ak:            ak,
+		bankKeeper:    bk,
+		sponsorKeeper: sk,
+		fallback:      ante.NewDeductFeeDecorator(ak, bk, fk),
+	}
+}
+
+func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	// simulations usually carry no fees, they still look up the sponsor so
+	// that they use as much gas as delivered txs
+	fee := feeTx.GetFee()
+	if feeTx.FeeGranter() != nil || (fee.IsZero() && !simulate) {
+		return dfd.fallback.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	contract, sponsored := dfd.sponsorKeeper.SponsoredContract(ctx, feeTx.GetMsgs())
+	if !sponsored {
+		return dfd.fallback.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	if addr := dfd.ak.GetModuleAddress(authtypes.FeeCollectorName); addr == nil {
+		return ctx, fmt.Errorf("fee collector module account (%s) has not been set", authtypes.FeeCollectorName)
+	}
+
+	feePayer := feeTx.FeePayer()
+	if dfd.ak.GetAccount(ctx, feePayer) == nil {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "fee payer address: %s does not exist", feePayer)
+	}
+
+	contractAcc := dfd.ak.GetAccount(ctx, contract)
+	if contractAcc == nil {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "sponsor address: %s does not exist", contract)
+	}
+
+	if err := dfd.sponsorKeeper.UseSponsoredFees(ctx, contract, feePayer, fee); err != nil {
+		return ctx, sdkerrors.Wrapf(err, "%s not allowed to pay fees for %s", contract, feePayer)
+	}
+
+	if err := ante.DeductFees(dfd.bankKeeper, ctx, contractAcc, fee); err != nil {
+		return ctx, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, contract.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeSponsorFee,
+			sdk.NewAttribute(types.AttributeKeyContract, contract.String()),
+			sdk.NewAttribute(types.AttributeKeyUser, feePayer.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+		),
+	})
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,31 @@
+package sponsor
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/oraichain/orai/x/sponsor/keeper"
+	"github.com/oraichain/orai/x/sponsor/types"
+)
+
+// InitGenesis import module genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	data types.GenesisState,
+) {
+	if err := data.Validate(); err != nil {
+		panic(err)
+	}
+
+	for _, sponsorship := range data.Sponsorships {
+		k.SetSponsorship(ctx, sponsorship)
+	}
+	for _, usage := range data.UserUsages {
+		k.SetUserUsage(ctx, usage)
+	}
+}
+
+// ExportGenesis export module state
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(k.GetAllSponsorships(ctx), k.GetAllUserUsages(ctx))
+}
@@ -0,0 +1,217 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	"github.com/oraichain/orai/x/sponsor/types"
+)
+
+// Keeper of the sponsor store
+type Keeper struct {
+	storeKey  storetypes.StoreKey
+	tStoreKey storetypes.StoreKey
+	cdc       codec.BinaryCodec
+
+	wasmKeeper types.WasmKeeper
+}
+
+func NewKeeper(
+	key storetypes.StoreKey,
+	tKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+	wasmKeeper types.WasmKeeper,
+) Keeper {
+	return Keeper{
+		storeKey:   key,
+		tStoreKey:  tKey,
+		cdc:        cdc,
+		wasmKeeper: wasmKeeper,
+	}
+}
+
+// SetSponsorship stores the sponsorship of a contract.
+func (k Keeper) SetSponsorship(ctx sdk.Context, sponsorship types.Sponsorship) {
+	contract := sdk.MustAccAddressFromBech32(sponsorship.Contract)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetSponsorshipKey(contract), k.cdc.MustMarshal(&sponsorship))
+}
+
+// GetSponsorship returns the sponsorship of a contract.
+func (k Keeper) GetSponsorship(ctx sdk.Context, contract sdk.AccAddress) (sponsorship types.Sponsorship, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetSponsorshipKey(contract))
+	if bz == nil {
+		return sponsorship, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &sponsorship)
+	return sponsorship, true
+}
+
+// DeleteSponsorship removes the sponsorship of a contract together with the
+// usage of its users.
+func (k Keeper) DeleteSponsorship(ctx sdk.Context, contract sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetSponsorshipKey(contract))
+
+	usageStore := prefix.NewStore(store, types.GetUserUsagePrefix(contract))
+	iter := usageStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	for _, key := range keys {
+		usageStore.Delete(key)
+	}
+}
+
+// IterateSponsorships iterates over all sponsorships until cb returns true.
+func (k Keeper) IterateSponsorships(ctx sdk.Context, cb func(sponsorship types.Sponsorship) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SponsorshipPrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var sponsorship types.Sponsorship
+		k.cdc.MustUnmarshal(iter.Value(), &sponsorship)
+		if cb(sponsorship) {
+			break
+		}
+	}
+}
+
+// GetAllSponsorships returns all sponsorships.
+func (k Keeper) GetAllSponsorships(ctx sdk.Context) []types.Sponsorship {
+	sponsorships := []types.Sponsorship{}
+	k.IterateSponsorships(ctx, func(sponsorship types.Sponsorship) bool {
+		sponsorships = append(sponsorships, sponsorship)
+		return false
+	})
+	return sponsorships
+}
+
+// SetUserUsage stores the fees a contract paid for a user so far.
+func (k Keeper) SetUserUsage(ctx sdk.Context, usage types.UserUsage) {
+	contract := sdk.MustAccAddressFromBech32(usage.Contract)
+	user := sdk.MustAccAddressFromBech32(usage.User)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetUserUsageKey(contract, user), k.cdc.MustMarshal(&usage))
+}
+
+// GetUserSpent returns the fees a contract paid for a user so far.
+func (k Keeper) GetUserSpent(ctx sdk.Context, contract, user sdk.AccAddress) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetUserUsageKey(contract, user))
+	if bz == nil {
+		return sdk.NewCoins()
+	}
+
+	var usage types.UserUsage
+	k.cdc.MustUnmarshal(bz, &usage)
+	return usage.Spent
+}
+
+// GetAllUserUsages returns the usage of all users of all contracts.
+func (k Keeper) GetAllUserUsages(ctx sdk.Context) []types.UserUsage {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UserUsagePrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	usages := []types.UserUsage{}
+	for ; iter.Valid(); iter.Next() {
+		var usage types.UserUsage
+		k.cdc.MustUnmarshal(iter.Value(), &usage)
+		usages = append(usages, usage)
+	}
+	return usages
+}
+
+// GetBlockSpent returns the fees a contract paid in the current block.
+func (k Keeper) GetBlockSpent(ctx sdk.Context, contract sdk.AccAddress) sdk.Coins {
+	store := ctx.TransientStore(k.tStoreKey)
+	bz := store.Get(types.GetBlockUsageKey(contract))
+	if bz == nil {
+		return sdk.NewCoins()
+	}
+
+	var usage types.UserUsage
+	k.cdc.MustUnmarshal(bz, &usage)
+	return usage.Spent
+}
+
+func (k Keeper) setBlockSpent(ctx sdk.Context, contract sdk.AccAddress, spent sdk.Coins) {
+	store := ctx.TransientStore(k.tStoreKey)
+	usage := types.UserUsage{Contract: contract.String(), Spent: spent}
+	store.Set(types.GetBlockUsageKey(contract), k.cdc.MustMarshal(&usage))
+}
+
+// SponsoredContract returns the contract that sponsors the given msgs. A tx
+// is sponsored only when all its msgs execute the same sponsoring contract.
+func (k Keeper) SponsoredContract(ctx sdk.Context, msgs []sdk.Msg) (sdk.AccAddress, bool) {
+	var contract string
+	for _, msg := range msgs {
+		execMsg, ok := msg.(*wasmtypes.MsgExecuteContract)
+		if !ok || (contract != "" && execMsg.Contract != contract) {
+			return nil, false
+		}
+		contract = execMsg.Contract
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(contract)
+	if err != nil {
+		return nil, false
+	}
+
+	if _, found := k.GetSponsorship(ctx, contractAddr); !found {
+		return nil, false
+	}
+
+	return contractAddr, true
+}
+
+// UseSponsoredFees records the fees a contract pays for a user and fails if
+// they go over the user or block limit.
+func (k Keeper) UseSponsoredFees(ctx sdk.Context, contract, user sdk.AccAddress, fee sdk.Coins) error {
+	sponsorship, found := k.GetSponsorship(ctx, contract)
+	if !found {
+		return sdkerrors.Wrap(types.ErrSponsorshipNotFound, contract.String())
+	}
+
+	userSpent := k.GetUserSpent(ctx, contract, user).Add(fee...)
+	if !userSpent.IsAllLTE(sponsorship.UserLimit) {
+		return sdkerrors.Wrapf(types.ErrUserLimitExceeded, "spent: %s limit: %s", userSpent, sponsorship.UserLimit)
+	}
+
+	blockSpent := k.GetBlockSpent(ctx, contract).Add(fee...)
+	if !blockSpent.IsAllLTE(sponsorship.BlockLimit) {
+		return sdkerrors.Wrapf(types.ErrBlockLimitExceeded, "spent: %s limit: %s", blockSpent, sponsorship.BlockLimit)
+	}
+
+	k.SetUserUsage(ctx, types.UserUsage{
+		Contract: contract.String(),
+		User:     user.String(),
+		Spent:    userSpent,
+	})
+	k.setBlockSpent(ctx, contract, blockSpent)
+
+	return nil
+}
+
+// IsAuthorized returns true if the sender can manage the sponsorship of the
+// contract, which is the contract itself or its admin.
+func (k Keeper) IsAuthorized(ctx sdk.Context, sender, contract sdk.AccAddress) bool {
+	if sender.Equals(contract) {
+		return true
+	}
+
+	info := k.wasmKeeper.GetContractInfo(ctx, contract)
+	return info != nil && info.Admin != "" && info.Admin == sender.String()
+}
@@ -0,0 +1,155 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	"github.com/oraichain/orai/x/sponsor/keeper"
+	"github.com/oraichain/orai/x/sponsor/types"
+)
+
+var (
+	contract = sdk.AccAddress("contract____________")
+	admin    = sdk.AccAddress("admin_______________")
+	alice    = sdk.AccAddress("alice_______________")
+	bob      = sdk.AccAddress("bob_________________")
+)
+
+type mockWasmKeeper struct{}
+
+func (mockWasmKeeper) GetContractInfo(_ sdk.Context, addr sdk.AccAddress) *wasmtypes.ContractInfo {
+	if !addr.Equals(contract) {
+		return nil
+	}
+	return &wasmtypes.ContractInfo{Admin: admin.String()}
+}
+
+func setupKeeper(t *testing.T) (sdk.Context, keeper.Keeper) {
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	tkey := sdk.NewTransientStoreKey(types.TStoreKey)
+
+	ms := store.NewCommitMultiStore(dbm.NewMemDB())
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(tkey, sdk.StoreTypeTransient, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	encCfg := simapp.MakeTestEncodingConfig()
+	k := keeper.NewKeeper(key, tkey, encCfg.Marshaler, mockWasmKeeper{})
+
+	return sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger()), k
+}
+
+func execMsg(contractAddr sdk.AccAddress) sdk.Msg {
+	return &wasmtypes.MsgExecuteContract{
+		Sender:   alice.String(),
+		Contract: contractAddr.String(),
+		Msg:      []byte(`{}`),
+	}
+}
+
+func TestSponsoredContract(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	other := sdk.AccAddress("other_______________")
+	k.SetSponsorship(ctx, types.NewSponsorship(contract, sdk.NewCoins(sdk.NewInt64Coin("orai", 10)), sdk.NewCoins(sdk.NewInt64Coin("orai", 10))))
+
+	cases := map[string]struct {
+		msgs      []sdk.Msg
+		sponsored bool
+	}{
+		"single execute": {
+			msgs:      []sdk.Msg{execMsg(contract)},
+			sponsored: true,
+		},
+		"multiple executes": {
+			msgs:      []sdk.Msg{execMsg(contract), execMsg(contract)},
+			sponsored: true,
+		},
+		"no msgs": {},
+		"contract without sponsorship": {
+			msgs: []sdk.Msg{execMsg(other)},
+		},
+		"different contracts": {
+			msgs: []sdk.Msg{execMsg(contract), execMsg(other)},
+		},
+		"other msg types": {
+			msgs: []sdk.Msg{execMsg(contract), banktypes.NewMsgSend(alice, bob, sdk.NewCoins(sdk.NewInt64Coin("orai", 1)))},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			addr, sponsored := k.SponsoredContract(ctx, tc.msgs)
+			require.Equal(t, tc.sponsored, sponsored)
+			if sponsored {
+				require.Equal(t, contract, addr)
+			}
+		})
+	}
+}
+
+func TestUseSponsoredFees(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	fee := sdk.NewCoins(sdk.NewInt64Coin("orai", 4))
+
+	require.ErrorIs(t, k.UseSponsoredFees(ctx, contract, alice, fee), types.ErrSponsorshipNotFound)
+
+	k.SetSponsorship(ctx, types.NewSponsorship(contract, sdk.NewCoins(sdk.NewInt64Coin("orai", 10)), sdk.NewCoins(sdk.NewInt64Coin("orai", 12))))
+
+	// alice uses up her limit
+	require.NoError(t, k.UseSponsoredFees(ctx, contract, alice, fee))
+	require.NoError(t, k.UseSponsoredFees(ctx, contract, alice, fee))
+	require.ErrorIs(t, k.UseSponsoredFees(ctx, contract, alice, fee), types.ErrUserLimitExceeded)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("orai", 8)), k.GetUserSpent(ctx, contract, alice))
+
+	// bob hits the block limit
+	require.NoError(t, k.UseSponsoredFees(ctx, contract, bob, fee))
+	require.ErrorIs(t, k.UseSponsoredFees(ctx, contract, bob, fee), types.ErrBlockLimitExceeded)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("orai", 12)), k.GetBlockSpent(ctx, contract))
+
+	// fees in a denom without limit are not sponsored
+	require.ErrorIs(t, k.UseSponsoredFees(ctx, contract, bob, sdk.NewCoins(sdk.NewInt64Coin("atom", 1))), types.ErrUserLimitExceeded)
+
+	k.DeleteSponsorship(ctx, contract)
+	_, found := k.GetSponsorship(ctx, contract)
+	require.False(t, found)
+	require.Empty(t, k.GetAllUserUsages(ctx))
+}
+
+func TestMsgServer(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+	limit := sdk.NewCoins(sdk.NewInt64Coin("orai", 10))
+
+	_, err := msgServer.SetSponsorship(sdk.WrapSDKContext(ctx), types.NewMsgSetSponsorship(alice, contract, limit, limit))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.SetSponsorship(sdk.WrapSDKContext(ctx), types.NewMsgSetSponsorship(admin, contract, limit, limit))
+	require.NoError(t, err)
+
+	_, err = msgServer.SetSponsorship(sdk.WrapSDKContext(ctx), types.NewMsgSetSponsorship(contract, contract, limit, limit.Add(limit...)))
+	require.NoError(t, err)
+
+	sponsorship, found := k.GetSponsorship(ctx, contract)
+	require.True(t, found)
+	require.Equal(t, types.NewSponsorship(contract, limit, limit.Add(limit...)), sponsorship)
+
+	_, err = msgServer.RemoveSponsorship(sdk.WrapSDKContext(ctx), types.NewMsgRemoveSponsorship(alice, contract))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.RemoveSponsorship(sdk.WrapSDKContext(ctx), types.NewMsgRemoveSponsorship(admin, contract))
+	require.NoError(t, err)
+
+	_, err = msgServer.RemoveSponsorship(sdk.WrapSDKContext(ctx), types.NewMsgRemoveSponsorship(admin, contract))
+	require.ErrorIs(t, err, types.ErrSponsorshipNotFound)
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/oraichain/orai/x/sponsor/types"
+)
+
+var _ types.MsgServer = &msgServer{}
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the sponsor MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{Keeper: k}
+}
+
+func (k msgServer) SetSponsorship(goCtx context.Context, msg *types.MsgSetSponsorship) (*types.MsgSetSponsorshipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, contract, err := k.authorize(ctx, msg.Sender, msg.Contract)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Keeper.SetSponsorship(ctx, types.NewSponsorship(contract, msg.UserLimit, msg.BlockLimit))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetSponsorship,
+			sdk.NewAttribute(types.AttributeKeyContract, msg.Contract),
+			sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyUserLimit, msg.UserLimit.String()),
+			sdk.NewAttribute(types.AttributeKeyBlockLimit, msg.BlockLimit.String()),
+		),
+	)
+
+	return &types.MsgSetSponsorshipResponse{}, nil
+}
+
+func (k msgServer) RemoveSponsorship(goCtx context.Context, msg *types.MsgRemoveSponsorship) (*types.MsgRemoveSponsorshipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, contract, err := k.authorize(ctx, msg.Sender, msg.Contract)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := k.GetSponsorship(ctx, contract); !found {
+		return nil, sdkerrors.Wrap(types.ErrSponsorshipNotFound, msg.Contract)
+	}
+
+	k.DeleteSponsorship(ctx, contract)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveSponsorship,
+			sdk.NewAttribute(types.AttributeKeyContract, msg.Contract),
+			sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+		),
+	)
+
+	return &types.MsgRemoveSponsorshipResponse{}, nil
+}
+
+func (k msgServer) authorize(ctx sdk.Context, senderBech32, contractBech32 string) (sender, contract sdk.AccAddress, err error) {
+	sender, err = sdk.AccAddressFromBech32(senderBech32)
+	if err != nil {
+		return nil, nil, err
+	}
+	contract, err = sdk.AccAddressFromBech32(contractBech32)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !k.IsAuthorized(ctx, sender, contract) {
+		return nil, nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is neither the contract nor its admin", sender)
+	}
+
+	return sender, contract, nil
+}
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/oraichain/orai/x/sponsor/types"
+)
+
+var _ types.QueryServer = &Querier{}
+
+type Querier struct {
+	keeper Keeper
+}
+
+func NewQuerier(k Keeper) Querier {
+	return Querier{
+		keeper: k,
+	}
+}
+
+// Sponsorship returns the sponsorship of a contract.
+func (q Querier) Sponsorship(stdCtx context.Context, req *types.QuerySponsorshipRequest) (*types.QuerySponsorshipResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	contract, err := sdk.AccAddressFromBech32(req.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	sponsorship, found := q.keeper.GetSponsorship(ctx, contract)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrSponsorshipNotFound, req.Contract)
+	}
+
+	return &types.QuerySponsorshipResponse{
+		Sponsorship: sponsorship,
+	}, nil
+}
+
+// Sponsorships returns all sponsorships.
+func (q Querier) Sponsorships(stdCtx context.Context, req *types.QuerySponsorshipsRequest) (*types.QuerySponsorshipsResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	store := prefix.NewStore(ctx.KVStore(q.keeper.storeKey), types.SponsorshipPrefix)
+
+	var sponsorships []types.Sponsorship
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var sponsorship types.Sponsorship
+		if err := q.keeper.cdc.Unmarshal(value, &sponsorship); err != nil {
+			return err
+		}
+		sponsorships = append(sponsorships, sponsorship)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySponsorshipsResponse{
+		Sponsorships: sponsorships,
+		Pagination:   pageRes,
+	}, nil
+}
+
+// UserUsage returns the fees a contract paid for a user so far.
+func (q Querier) UserUsage(stdCtx context.Context, req *types.QueryUserUsageRequest) (*types.QueryUserUsageResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	contract, err := sdk.AccAddressFromBech32(req.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	user, err := sdk.AccAddressFromBech32(req.User)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+	return &types.QueryUserUsageResponse{
+		Spent: q.keeper.GetUserSpent(ctx, contract, user),
+	}, nil
+}
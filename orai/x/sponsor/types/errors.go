@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/sponsor module sentinel errors
+var (
+	ErrSponsorshipNotFound = sdkerrors.Register(ModuleName, 2, "sponsorship not found")
+	ErrUserLimitExceeded   = sdkerrors.Register(ModuleName, 3, "sponsored fees exceed the user limit")
+	ErrBlockLimitExceeded  = sdkerrors.Register(ModuleName, 4, "sponsored fees exceed the block limit")
+)
@@ -0,0 +1,14 @@
+package types
+
+// sponsor module event types
+const (
+	EventTypeSetSponsorship    = "set_sponsorship"
+	EventTypeRemoveSponsorship = "remove_sponsorship"
+	EventTypeSponsorFee        = "sponsor_fee"
+
+	AttributeKeyContract   = "contract"
+	AttributeKeySender     = "sender"
+	AttributeKeyUser       = "user"
+	AttributeKeyUserLimit  = "user_limit"
+	AttributeKeyBlockLimit = "block_limit"
+)
@@ -0,0 +1,46 @@
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(sponsorships []Sponsorship, userUsages []UserUsage) *GenesisState {
+	return &GenesisState{
+		Sponsorships: sponsorships,
+		UserUsages:   userUsages,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState([]Sponsorship{}, []UserUsage{})
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	sponsored := make(map[string]bool, len(gs.Sponsorships))
+	for _, s := range gs.Sponsorships {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+		if sponsored[s.Contract] {
+			return fmt.Errorf("duplicate sponsorship for %s", s.Contract)
+		}
+		sponsored[s.Contract] = true
+	}
+
+	seen := make(map[string]bool, len(gs.UserUsages))
+	for _, u := range gs.UserUsages {
+		if err := u.Validate(); err != nil {
+			return err
+		}
+		if !sponsored[u.Contract] {
+			return fmt.Errorf("usage of %s for a contract without sponsorship: %s", u.User, u.Contract)
+		}
+		if seen[u.Contract+u.User] {
+			return fmt.Errorf("duplicate usage of %s for %s", u.User, u.Contract)
+		}
+		seen[u.Contract+u.User] = true
+	}
+
+	return nil
+}
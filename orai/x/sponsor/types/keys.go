@@ -0,0 +1,52 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "sponsor"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// TStoreKey defines the transient store key, used for the per-block usage
+	TStoreKey = "transient_" + ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+var (
+	SponsorshipPrefix = []byte{0x01}
+	UserUsagePrefix   = []byte{0x02}
+	BlockUsagePrefix  = []byte{0x03}
+)
+
+// GetSponsorshipKey returns the store key of a contract's sponsorship.
+func GetSponsorshipKey(contract sdk.AccAddress) []byte {
+	return append(SponsorshipPrefix, address.MustLengthPrefix(contract)...)
+}
+
+// GetUserUsagePrefix returns the prefix of all user usages of a contract.
+func GetUserUsagePrefix(contract sdk.AccAddress) []byte {
+	return append(UserUsagePrefix, address.MustLengthPrefix(contract)...)
+}
+
+// GetUserUsageKey returns the store key of the fees a contract paid for a
+// user.
+func GetUserUsageKey(contract, user sdk.AccAddress) []byte {
+	return append(GetUserUsagePrefix(contract), address.MustLengthPrefix(user)...)
+}
+
+// GetBlockUsageKey returns the transient store key of the fees a contract
+// paid in the current block.
+func GetBlockUsageKey(contract sdk.AccAddress) []byte {
+	return append(BlockUsagePrefix, address.MustLengthPrefix(contract)...)
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgSetSponsorship    = "set_sponsorship"
+	TypeMsgRemoveSponsorship = "remove_sponsorship"
+)
+
+var (
+	_ sdk.Msg = &MsgSetSponsorship{}
+	_ sdk.Msg = &MsgRemoveSponsorship{}
+)
+
+// NewMsgSetSponsorship creates a new MsgSetSponsorship instance.
+func NewMsgSetSponsorship(sender, contract sdk.AccAddress, userLimit, blockLimit sdk.Coins) *MsgSetSponsorship {
+	return &MsgSetSponsorship{
+		Sender:     sender.String(),
+		Contract:   contract.String(),
+		UserLimit:  userLimit,
+		BlockLimit: blockLimit,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgSetSponsorship) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgSetSponsorship) Type() string { return TypeMsgSetSponsorship }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetSponsorship) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address: %s", err)
+	}
+	if err := validateLimits(msg.UserLimit, msg.BlockLimit); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgSetSponsorship) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetSponsorship) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgRemoveSponsorship creates a new MsgRemoveSponsorship instance.
+func NewMsgRemoveSponsorship(sender, contract sdk.AccAddress) *MsgRemoveSponsorship {
+	return &MsgRemoveSponsorship{
+		Sender:   sender.String(),
+		Contract: contract.String(),
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgRemoveSponsorship) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgRemoveSponsorship) Type() string { return TypeMsgRemoveSponsorship }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRemoveSponsorship) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address: %s", err)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgRemoveSponsorship) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRemoveSponsorship) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
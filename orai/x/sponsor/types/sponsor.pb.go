@@ -0,0 +1,744 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: orai/sponsor/v1/sponsor.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Sponsorship lets a contract pay the fees of txs that only execute it.
+type Sponsorship struct {
+	// contract is the bech32 address of the sponsoring contract, fees are paid
+	// from its balance.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// user_limit is the total amount of fees the contract pays for a single
+	// user.
+	UserLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=user_limit,json=userLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"user_limit" yaml:"user_limit"`
+	// block_limit is the amount of fees the contract pays within a block.
+	BlockLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=block_limit,json=blockLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"block_limit" yaml:"block_limit"`
+}
+
+func (m *Sponsorship) Reset()         { *m = Sponsorship{} }
+func (m *Sponsorship) String() string { return proto.CompactTextString(m) }
+func (*Sponsorship) ProtoMessage()    {}
+func (*Sponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad9b37c3ffe73d7f, []int{0}
+}
+func (m *Sponsorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Sponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Sponsorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Sponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Sponsorship.Merge(m, src)
+}
+func (m *Sponsorship) XXX_Size() int {
+	return m.Size()
+}
+func (m *Sponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_Sponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Sponsorship proto.InternalMessageInfo
+
+func (m *Sponsorship) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *Sponsorship) GetUserLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.UserLimit
+	}
+	return nil
+}
+
+func (m *Sponsorship) GetBlockLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BlockLimit
+	}
+	return nil
+}
+
+// UserUsage is the amount of fees a contract paid for a user so far.
+type UserUsage struct {
+	Contract string                                   `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	User     string                                   `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Spent    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
+}
+
+func (m *UserUsage) Reset()         { *m = UserUsage{} }
+func (m *UserUsage) String() string { return proto.CompactTextString(m) }
+func (*UserUsage) ProtoMessage()    {}
+func (*UserUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad9b37c3ffe73d7f, []int{1}
+}
+func (m *UserUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UserUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserUsage.Merge(m, src)
+}
+func (m *UserUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserUsage proto.InternalMessageInfo
+
+func (m *UserUsage) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *UserUsage) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *UserUsage) GetSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spent
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Sponsorship)(nil), "orai.sponsor.v1.Sponsorship")
+	proto.RegisterType((*UserUsage)(nil), "orai.sponsor.v1.UserUsage")
+}
+
+func init() { proto.RegisterFile("orai/sponsor/v1/sponsor.proto", fileDescriptor_ad9b37c3ffe73d7f) }
+
+var fileDescriptor_ad9b37c3ffe73d7f = []byte{
+	// 354 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xb1, 0x4e, 0xeb, 0x30,
+	0x14, 0x86, 0x93, 0xf6, 0xde, 0xab, 0x5b, 0x77, 0x40, 0x44, 0x0c, 0xa5, 0x12, 0x69, 0x95, 0xa9,
+	0x0c, 0xd8, 0x04, 0x36, 0xc6, 0x56, 0x30, 0x31, 0x15, 0x75, 0x61, 0x41, 0x8e, 0xb1, 0x52, 0xab,
+	0x4d, 0x4e, 0x14, 0xbb, 0x15, 0x9d, 0x90, 0x78, 0x02, 0x9e, 0x80, 0x07, 0x60, 0xe1, 0x35, 0x3a,
+	0x76, 0x64, 0x2a, 0xa8, 0x7d, 0x03, 0x9e, 0x00, 0xd9, 0x4e, 0x4b, 0x27, 0xa0, 0x93, 0x7f, 0x9f,
+	0xe3, 0xff, 0x3f, 0x9f, 0xac, 0x83, 0x0e, 0x20, 0xa7, 0x82, 0xc8, 0x0c, 0x52, 0x09, 0x39, 0x19,
+	0x87, 0x2b, 0x89, 0xb3, 0x1c, 0x14, 0x78, 0x3b, 0xba, 0x8d, 0x57, 0xb5, 0x71, 0x58, 0xdf, 0x8b,
+	0x21, 0x06, 0xd3, 0x23, 0x5a, 0xd9, 0x67, 0x75, 0x9f, 0x81, 0x4c, 0x40, 0x92, 0x88, 0x4a, 0x4e,
+	0xc6, 0x61, 0xc4, 0x15, 0x0d, 0x09, 0x03, 0x91, 0xda, 0x7e, 0xf0, 0x52, 0x42, 0xd5, 0x2b, 0x1b,
+	0x22, 0xfb, 0x22, 0xf3, 0xea, 0xe8, 0x3f, 0x83, 0x54, 0xe5, 0x94, 0xa9, 0x9a, 0xdb, 0x74, 0x5b,
+	0x95, 0xee, 0xfa, 0xee, 0xdd, 0x23, 0x34, 0x92, 0x3c, 0xbf, 0x19, 0x8a, 0x44, 0xa8, 0x5a, 0xa9,
+	0x59, 0x6e, 0x55, 0x4f, 0xf6, 0xb1, 0x1d, 0x80, 0xf5, 0x00, 0x5c, 0x0c, 0xc0, 0x1d, 0x10, 0x69,
+	0xfb, 0x7c, 0x3a, 0x6f, 0x38, 0x1f, 0xf3, 0xc6, 0xee, 0x84, 0x26, 0xc3, 0xb3, 0xe0, 0xcb, 0x1a,
+	0x3c, 0xbf, 0x35, 0x5a, 0xb1, 0x50, 0xfd, 0x51, 0x84, 0x19, 0x24, 0xa4, 0x40, 0xb4, 0xc7, 0x91,
+	0xbc, 0x1d, 0x10, 0x35, 0xc9, 0xb8, 0x34, 0x29, 0xb2, 0x5b, 0xd1, 0xc6, 0x4b, 0xed, 0xf3, 0x1e,
+	0x5c, 0x54, 0x8d, 0x86, 0xc0, 0x06, 0x05, 0x42, 0xf9, 0x27, 0x84, 0x8b, 0x02, 0xc1, 0xb3, 0x08,
+	0x1b, 0xde, 0xed, 0x18, 0x90, 0x71, 0x1a, 0x88, 0xe0, 0xc9, 0x45, 0x95, 0x9e, 0xe4, 0x79, 0x4f,
+	0xd2, 0x98, 0x7f, 0xfb, 0x5f, 0x1e, 0xfa, 0xa3, 0xd9, 0x6b, 0x25, 0x53, 0x37, 0xda, 0xa3, 0xe8,
+	0xaf, 0xcc, 0x78, 0xfa, 0x0b, 0xf6, 0x63, 0xcd, 0xbe, 0x15, 0xa5, 0x4d, 0x6e, 0x77, 0xa6, 0x0b,
+	0xdf, 0x9d, 0x2d, 0x7c, 0xf7, 0x7d, 0xe1, 0xbb, 0x8f, 0x4b, 0xdf, 0x99, 0x2d, 0x7d, 0xe7, 0x75,
+	0xe9, 0x3b, 0xd7, 0x87, 0x1b, 0x51, 0x7a, 0x7d, 0x58, 0x9f, 0x8a, 0xd4, 0x28, 0x72, 0xb7, 0xde,
+	0x34, 0x93, 0x18, 0xfd, 0x33, 0xeb, 0x71, 0xfa, 0x39, 0x00, 0x24, 0xab, 0x6a, 0x5a, 0x86, 0x02,
+	0x00, 0x00,
+}
+
+func (m *Sponsorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Sponsorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Sponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockLimit) > 0 {
+		for iNdEx := len(m.BlockLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSponsor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.UserLimit) > 0 {
+		for iNdEx := len(m.UserLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UserLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSponsor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintSponsor(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSponsor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintSponsor(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintSponsor(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSponsor(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSponsor(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Sponsorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovSponsor(uint64(l))
+	}
+	if len(m.UserLimit) > 0 {
+		for _, e := range m.UserLimit {
+			l = e.Size()
+			n += 1 + l + sovSponsor(uint64(l))
+		}
+	}
+	if len(m.BlockLimit) > 0 {
+		for _, e := range m.BlockLimit {
+			l = e.Size()
+			n += 1 + l + sovSponsor(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UserUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovSponsor(uint64(l))
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovSponsor(uint64(l))
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovSponsor(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovSponsor(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSponsor(x uint64) (n int) {
+	return sovSponsor(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Sponsorship) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSponsor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sponsorship: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserLimit = append(m.UserLimit, types.Coin{})
+			if err := m.UserLimit[len(m.UserLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockLimit = append(m.BlockLimit, types.Coin{})
+			if err := m.BlockLimit[len(m.BlockLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSponsor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSponsor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSponsor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSponsor(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSponsor
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSponsor
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSponsor
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSponsor
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSponsor        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSponsor          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSponsor = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewSponsorship creates a new Sponsorship instance.
+func NewSponsorship(contract sdk.AccAddress, userLimit, blockLimit sdk.Coins) Sponsorship {
+	return Sponsorship{
+		Contract:   contract.String(),
+		UserLimit:  userLimit,
+		BlockLimit: blockLimit,
+	}
+}
+
+// Validate performs basic validation of a sponsorship.
+func (s Sponsorship) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(s.Contract); err != nil {
+		return fmt.Errorf("invalid contract address: %w", err)
+	}
+	return validateLimits(s.UserLimit, s.BlockLimit)
+}
+
+// Validate performs basic validation of a user usage.
+func (u UserUsage) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(u.Contract); err != nil {
+		return fmt.Errorf("invalid contract address: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(u.User); err != nil {
+		return fmt.Errorf("invalid user address: %w", err)
+	}
+	if !u.Spent.IsValid() {
+		return fmt.Errorf("invalid spent amount: %s", u.Spent)
+	}
+	return nil
+}
+
+func validateLimits(userLimit, blockLimit sdk.Coins) error {
+	if userLimit.Empty() || !userLimit.IsValid() {
+		return fmt.Errorf("invalid user limit: %s", userLimit)
+	}
+	if blockLimit.Empty() || !blockLimit.IsValid() {
+		return fmt.Errorf("invalid block limit: %s", blockLimit)
+	}
+	return nil
+}
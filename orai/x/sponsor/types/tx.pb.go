@@ -0,0 +1,1097 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: orai/sponsor/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgSetSponsorship is sent by the contract itself or by its admin.
+type MsgSetSponsorship struct {
+	Sender     string                                   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Contract   string                                   `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	UserLimit  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=user_limit,json=userLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"user_limit"`
+	BlockLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=block_limit,json=blockLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"block_limit"`
+}
+
+func (m *MsgSetSponsorship) Reset()         { *m = MsgSetSponsorship{} }
+func (m *MsgSetSponsorship) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSponsorship) ProtoMessage()    {}
+func (*MsgSetSponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fea01959db94d7c4, []int{0}
+}
+func (m *MsgSetSponsorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSponsorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSponsorship.Merge(m, src)
+}
+func (m *MsgSetSponsorship) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSponsorship proto.InternalMessageInfo
+
+func (m *MsgSetSponsorship) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetSponsorship) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *MsgSetSponsorship) GetUserLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.UserLimit
+	}
+	return nil
+}
+
+func (m *MsgSetSponsorship) GetBlockLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BlockLimit
+	}
+	return nil
+}
+
+// MsgSetSponsorshipResponse defines the Msg/SetSponsorship response type.
+type MsgSetSponsorshipResponse struct {
+}
+
+func (m *MsgSetSponsorshipResponse) Reset()         { *m = MsgSetSponsorshipResponse{} }
+func (m *MsgSetSponsorshipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSponsorshipResponse) ProtoMessage()    {}
+func (*MsgSetSponsorshipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fea01959db94d7c4, []int{1}
+}
+func (m *MsgSetSponsorshipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSponsorshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSponsorshipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSponsorshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSponsorshipResponse.Merge(m, src)
+}
+func (m *MsgSetSponsorshipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSponsorshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSponsorshipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSponsorshipResponse proto.InternalMessageInfo
+
+// MsgRemoveSponsorship is sent by the contract itself or by its admin.
+type MsgRemoveSponsorship struct {
+	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (m *MsgRemoveSponsorship) Reset()         { *m = MsgRemoveSponsorship{} }
+func (m *MsgRemoveSponsorship) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveSponsorship) ProtoMessage()    {}
+func (*MsgRemoveSponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fea01959db94d7c4, []int{2}
+}
+func (m *MsgRemoveSponsorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveSponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveSponsorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveSponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveSponsorship.Merge(m, src)
+}
+func (m *MsgRemoveSponsorship) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveSponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveSponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveSponsorship proto.InternalMessageInfo
+
+func (m *MsgRemoveSponsorship) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRemoveSponsorship) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+// MsgRemoveSponsorshipResponse defines the Msg/RemoveSponsorship response
+// type.
+type MsgRemoveSponsorshipResponse struct {
+}
+
+func (m *MsgRemoveSponsorshipResponse) Reset()         { *m = MsgRemoveSponsorshipResponse{} }
+func (m *MsgRemoveSponsorshipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveSponsorshipResponse) ProtoMessage()    {}
+func (*MsgRemoveSponsorshipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fea01959db94d7c4, []int{3}
+}
+func (m *MsgRemoveSponsorshipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveSponsorshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveSponsorshipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveSponsorshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveSponsorshipResponse.Merge(m, src)
+}
+func (m *MsgRemoveSponsorshipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveSponsorshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveSponsorshipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveSponsorshipResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSetSponsorship)(nil), "orai.sponsor.v1.MsgSetSponsorship")
+	proto.RegisterType((*MsgSetSponsorshipResponse)(nil), "orai.sponsor.v1.MsgSetSponsorshipResponse")
+	proto.RegisterType((*MsgRemoveSponsorship)(nil), "orai.sponsor.v1.MsgRemoveSponsorship")
+	proto.RegisterType((*MsgRemoveSponsorshipResponse)(nil), "orai.sponsor.v1.MsgRemoveSponsorshipResponse")
+}
+
+func init() { proto.RegisterFile("orai/sponsor/v1/tx.proto", fileDescriptor_fea01959db94d7c4) }
+
+var fileDescriptor_fea01959db94d7c4 = []byte{
+	// 383 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xc1, 0x6a, 0xea, 0x40,
+	0x14, 0x86, 0x13, 0xbd, 0xc8, 0x75, 0x84, 0x7b, 0x31, 0xc8, 0x25, 0xe6, 0x96, 0x51, 0x02, 0x05,
+	0x5b, 0x70, 0xa6, 0xb1, 0x6f, 0xa0, 0xbb, 0x52, 0x37, 0x71, 0xd7, 0x4d, 0x9b, 0xc4, 0x21, 0x4e,
+	0x35, 0x99, 0x90, 0x19, 0x83, 0x7d, 0x8b, 0xbe, 0x42, 0xb7, 0x7d, 0x12, 0x97, 0xd2, 0x55, 0x57,
+	0x6d, 0xd1, 0x17, 0x29, 0x99, 0x44, 0x29, 0x46, 0x68, 0xa1, 0x5d, 0x65, 0x86, 0xff, 0xcf, 0xff,
+	0x9d, 0x9c, 0x73, 0x02, 0x74, 0x16, 0x3b, 0x14, 0xf3, 0x88, 0x85, 0x9c, 0xc5, 0x38, 0xb1, 0xb0,
+	0x58, 0xa0, 0x28, 0x66, 0x82, 0x69, 0x7f, 0x53, 0x05, 0xe5, 0x0a, 0x4a, 0x2c, 0xa3, 0xe1, 0x33,
+	0x9f, 0x49, 0x0d, 0xa7, 0xa7, 0xcc, 0x66, 0x40, 0x8f, 0xf1, 0x80, 0x71, 0xec, 0x3a, 0x9c, 0xe0,
+	0xc4, 0x72, 0x89, 0x70, 0x2c, 0xec, 0x31, 0x1a, 0x66, 0xba, 0xf9, 0x50, 0x02, 0xf5, 0x21, 0xf7,
+	0x47, 0x44, 0x8c, 0xb2, 0x28, 0x3e, 0xa1, 0x91, 0xf6, 0x0f, 0x54, 0x38, 0x09, 0xc7, 0x24, 0xd6,
+	0xd5, 0xb6, 0xda, 0xa9, 0xda, 0xf9, 0x4d, 0x33, 0xc0, 0x6f, 0x8f, 0x85, 0x22, 0x76, 0x3c, 0xa1,
+	0x97, 0xa4, 0xb2, 0xbb, 0x6b, 0xb7, 0x00, 0xcc, 0x39, 0x89, 0xaf, 0x67, 0x34, 0xa0, 0x42, 0x2f,
+	0xb7, 0xcb, 0x9d, 0x5a, 0xaf, 0x89, 0x32, 0x3c, 0x4a, 0xf1, 0x28, 0xc7, 0xa3, 0x01, 0xa3, 0x61,
+	0xff, 0x6c, 0xf9, 0xd2, 0x52, 0x1e, 0x5f, 0x5b, 0x1d, 0x9f, 0x8a, 0xc9, 0xdc, 0x45, 0x1e, 0x0b,
+	0x70, 0x5e, 0x6b, 0xf6, 0xe8, 0xf2, 0xf1, 0x14, 0x8b, 0xbb, 0x88, 0x70, 0xf9, 0x02, 0xb7, 0xab,
+	0x69, 0xfc, 0x65, 0x9a, 0xae, 0xcd, 0x40, 0xcd, 0x9d, 0x31, 0x6f, 0x9a, 0xc3, 0x7e, 0xfd, 0x3c,
+	0x0c, 0xc8, 0x7c, 0x49, 0x33, 0xff, 0x83, 0x66, 0xa1, 0x45, 0x36, 0x91, 0xad, 0x27, 0xe6, 0x05,
+	0x68, 0x0c, 0xb9, 0x6f, 0x93, 0x80, 0x25, 0xe4, 0x9b, 0x2d, 0x34, 0x21, 0x38, 0x3a, 0x94, 0xb5,
+	0x65, 0xf5, 0x9e, 0x54, 0x50, 0x1e, 0x72, 0x5f, 0xbb, 0x01, 0x7f, 0xf6, 0x06, 0x66, 0xa2, 0xbd,
+	0x75, 0x40, 0x85, 0x8a, 0x8d, 0xd3, 0xcf, 0x3d, 0x5b, 0x92, 0x46, 0x41, 0xbd, 0xf8, 0x49, 0xc7,
+	0x87, 0x02, 0x0a, 0x36, 0xa3, 0xfb, 0x25, 0xdb, 0x16, 0xd5, 0x1f, 0x2c, 0xd7, 0x50, 0x5d, 0xad,
+	0xa1, 0xfa, 0xb6, 0x86, 0xea, 0xfd, 0x06, 0x2a, 0xab, 0x0d, 0x54, 0x9e, 0x37, 0x50, 0xb9, 0x3a,
+	0xf9, 0x30, 0xad, 0x34, 0xd2, 0x9b, 0x38, 0x34, 0x94, 0x27, 0xbc, 0xd8, 0xfd, 0x13, 0x72, 0x68,
+	0x6e, 0x45, 0x6e, 0xf3, 0xf9, 0xfb, 0x00, 0xc5, 0x1e, 0x7e, 0xff, 0x30, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// SetSponsorship makes a contract pay the fees of txs that only execute it,
+	// or updates its limits.
+	SetSponsorship(ctx context.Context, in *MsgSetSponsorship, opts ...grpc.CallOption) (*MsgSetSponsorshipResponse, error)
+	// RemoveSponsorship stops a contract from paying fees.
+	RemoveSponsorship(ctx context.Context, in *MsgRemoveSponsorship, opts ...grpc.CallOption) (*MsgRemoveSponsorshipResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SetSponsorship(ctx context.Context, in *MsgSetSponsorship, opts ...grpc.CallOption) (*MsgSetSponsorshipResponse, error) {
+	out := new(MsgSetSponsorshipResponse)
+	err := c.cc.Invoke(ctx, "/orai.sponsor.v1.Msg/SetSponsorship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveSponsorship(ctx context.Context, in *MsgRemoveSponsorship, opts ...grpc.CallOption) (*MsgRemoveSponsorshipResponse, error) {
+	out := new(MsgRemoveSponsorshipResponse)
+	err := c.cc.Invoke(ctx, "/orai.sponsor.v1.Msg/RemoveSponsorship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// SetSponsorship makes a contract pay the fees of txs that only execute it,
+	// or updates its limits.
+	SetSponsorship(context.Context, *MsgSetSponsorship) (*MsgSetSponsorshipResponse, error)
+	// RemoveSponsorship stops a contract from paying fees.
+	RemoveSponsorship(context.Context, *MsgRemoveSponsorship) (*MsgRemoveSponsorshipResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SetSponsorship(ctx context.Context, req *MsgSetSponsorship) (*MsgSetSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSponsorship not implemented")
+}
+func (*UnimplementedMsgServer) RemoveSponsorship(ctx context.Context, req *MsgRemoveSponsorship) (*MsgRemoveSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSponsorship not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SetSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSponsorship)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orai.sponsor.v1.Msg/SetSponsorship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSponsorship(ctx, req.(*MsgSetSponsorship))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveSponsorship)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orai.sponsor.v1.Msg/RemoveSponsorship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveSponsorship(ctx, req.(*MsgRemoveSponsorship))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "orai.sponsor.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetSponsorship",
+			Handler:    _Msg_SetSponsorship_Handler,
+		},
+		{
+			MethodName: "RemoveSponsorship",
+			Handler:    _Msg_RemoveSponsorship_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "orai/sponsor/v1/tx.proto",
+}
+
+func (m *MsgSetSponsorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSponsorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockLimit) > 0 {
+		for iNdEx := len(m.BlockLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.UserLimit) > 0 {
+		for iNdEx := len(m.UserLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UserLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSponsorshipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSponsorshipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSponsorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveSponsorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveSponsorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveSponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveSponsorshipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveSponsorshipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveSponsorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetSponsorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.UserLimit) > 0 {
+		for _, e := range m.UserLimit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.BlockLimit) > 0 {
+		for _, e := range m.BlockLimit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetSponsorshipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveSponsorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveSponsorshipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetSponsorship) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSponsorship: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserLimit = append(m.UserLimit, types.Coin{})
+			if err := m.UserLimit[len(m.UserLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockLimit = append(m.BlockLimit, types.Coin{})
+			if err := m.BlockLimit[len(m.BlockLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSponsorshipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSponsorshipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSponsorshipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveSponsorship) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveSponsorship: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveSponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveSponsorshipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveSponsorshipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveSponsorshipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,36 @@
+package tokenfactory
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/oraichain/orai/x/tokenfactory/keeper"
+)
+
+// BankAppModule is the bank module serving its msgs with the bank keeper
+// calling the before send hooks. The bank module of this SDK version requires
+// a BaseKeeper to register its services.
+type BankAppModule struct {
+	bank.AppModule
+
+	keeper *keeper.BankKeeper
+}
+
+// NewBankAppModule constructor
+func NewBankAppModule(cdc codec.Codec, bankKeeper *keeper.BankKeeper, accountKeeper banktypes.AccountKeeper) BankAppModule {
+	return BankAppModule{
+		AppModule: bank.NewAppModule(cdc, bankKeeper, accountKeeper),
+		keeper:    bankKeeper,
+	}
+}
+
+func (am BankAppModule) RegisterServices(cfg module.Configurator) {
+	banktypes.RegisterMsgServer(cfg.MsgServer(), bankkeeper.NewMsgServerImpl(am.keeper))
+	banktypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := bankkeeper.NewMigrator(am.keeper.BaseKeeper)
+	cfg.RegisterMigration(banktypes.ModuleName, 1, m.Migrate1to2)
+}
@@ -0,0 +1,210 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/oraichain/orai/x/tokenfactory/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Token factory transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(
+		GetCmdCreateDenom(),
+		GetCmdMint(),
+		GetCmdBurn(),
+		GetCmdChangeAdmin(),
+		GetCmdSetDenomMetadata(),
+		GetCmdSetBeforeSendHook(),
+	)
+	return txCmd
+}
+
+func GetCmdCreateDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "create-denom [subdenom]",
+		Short:   "Create the denom factory/{sender}/{subdenom}, paying the creation fee",
+		Example: "oraid tx tokenfactory create-denom mytoken --from sender",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateDenom(clientCtx.GetFromAddress(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdMint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "mint [amount] [mint-to-address]",
+		Short:   "Mint an amount of a denom administered by the sender, to the sender by default",
+		Example: "oraid tx tokenfactory mint 1000factory/orai1.../mytoken --from sender",
+		Args:    cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			var mintTo string
+			if len(args) > 1 {
+				mintTo = args[1]
+			}
+
+			msg := types.NewMsgMint(clientCtx.GetFromAddress(), amount, mintTo)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdBurn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "burn [amount]",
+		Short:   "Burn an amount of a denom administered by the sender, from the sender",
+		Example: "oraid tx tokenfactory burn 1000factory/orai1.../mytoken --from sender",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBurn(clientCtx.GetFromAddress(), amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdChangeAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "change-admin [denom] [new-admin]",
+		Short:   "Change the admin of a denom, an empty admin leaving it without one",
+		Example: "oraid tx tokenfactory change-admin factory/orai1.../mytoken orai1... --from sender",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgChangeAdmin(clientCtx.GetFromAddress(), args[0], args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdSetDenomMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-metadata [metadata-file]",
+		Short: "Set the bank metadata of a denom administered by the sender",
+		Long: `Set the bank metadata of a denom administered by the sender, read from a JSON
+file. The base of the metadata is the denom.`,
+		Example: "oraid tx tokenfactory set-denom-metadata metadata.json --from sender",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var metadata banktypes.Metadata
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &metadata); err != nil {
+				return fmt.Errorf("invalid metadata: %w", err)
+			}
+
+			msg := types.NewMsgSetDenomMetadata(clientCtx.GetFromAddress(), metadata)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdSetBeforeSendHook() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-before-send-hook [denom] [contract]",
+		Short: "Set the contract called before each send of a denom administered by the sender",
+		Long: `Set the contract called with the block_before_send sudo msg before each send of
+a denom administered by the sender. The send is rejected when the contract
+returns an error. An empty contract removes the hook.`,
+		Example: "oraid tx tokenfactory set-before-send-hook factory/orai1.../mytoken orai1... --from sender",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetBeforeSendHook(clientCtx.GetFromAddress(), args[0], args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
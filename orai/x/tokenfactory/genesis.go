@@ -0,0 +1,51 @@
+package tokenfactory
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/oraichain/orai/x/tokenfactory/keeper"
+	"github.com/oraichain/orai/x/tokenfactory/types"
+)
+
+// InitGenesis import module genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	ak types.AccountKeeper,
+	data types.GenesisState,
+) {
+	if err := data.Validate(); err != nil {
+		panic(err)
+	}
+
+	k.SetParams(ctx, data.Params)
+
+	// make sure the module account with its permissions exists
+	ak.GetModuleAccount(ctx, types.ModuleName)
+
+	// the creation fee is not charged again
+	for _, denom := range data.FactoryDenoms {
+		creator, _, err := types.DeconstructDenom(denom.Denom)
+		if err != nil {
+			panic(err)
+		}
+		k.RegisterDenom(ctx, creator, denom.Denom)
+		k.SetAuthorityMetadata(ctx, denom.Denom, denom.AuthorityMetadata)
+		k.StoreBeforeSendHook(ctx, denom.Denom, denom.BeforeSendHook)
+	}
+}
+
+// ExportGenesis export module state
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	denoms := []types.GenesisDenom{}
+	k.IterateDenoms(ctx, func(denom string, metadata types.DenomAuthorityMetadata) bool {
+		denoms = append(denoms, types.GenesisDenom{
+			Denom:             denom,
+			AuthorityMetadata: metadata,
+			BeforeSendHook:    k.GetBeforeSendHook(ctx, denom),
+		})
+		return false
+	})
+
+	return types.NewGenesisState(k.GetParams(ctx), denoms)
+}
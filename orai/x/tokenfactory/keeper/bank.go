@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// BeforeSendHooks is called by the bank keeper before moving coins.
+type BeforeSendHooks interface {
+	BlockBeforeSend(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) error
+}
+
+var _ bankkeeper.Keeper = &BankKeeper{}
+
+// BankKeeper wraps the bank keeper to call the before send hooks of the
+// factory denoms, as the bank keeper of this SDK version has no send hooks.
+// The hooks are not called on sends between module accounts.
+// The hooks are set once the tokenfactory keeper is built, after the keepers
+// depending on the bank keeper.
+type BankKeeper struct {
+	bankkeeper.BaseKeeper
+
+	hooks BeforeSendHooks
+}
+
+// NewBankKeeper wraps a bank keeper.
+func NewBankKeeper(keeper bankkeeper.BaseKeeper) *BankKeeper {
+	return &BankKeeper{BaseKeeper: keeper}
+}
+
+// SetHooks sets the before send hooks.
+func (k *BankKeeper) SetHooks(hooks BeforeSendHooks) {
+	k.hooks = hooks
+}
+
+func (k *BankKeeper) blockBeforeSend(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.BlockBeforeSend(ctx, from, to, amount)
+}
+
+// SendCoins calls the hooks before sending the coins.
+func (k *BankKeeper) SendCoins(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	if err := k.blockBeforeSend(ctx, from, to, amount); err != nil {
+		return err
+	}
+	return k.BaseKeeper.SendCoins(ctx, from, to, amount)
+}
+
+// InputOutputCoins calls the hooks for each output before sending the coins.
+// The outputs are checked against the first input, as multi sends are limited
+// to a single input.
+func (k *BankKeeper) InputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error {
+	if len(inputs) > 0 {
+		from, err := sdk.AccAddressFromBech32(inputs[0].Address)
+		if err != nil {
+			return err
+		}
+		for _, output := range outputs {
+			to, err := sdk.AccAddressFromBech32(output.Address)
+			if err != nil {
+				return err
+			}
+			if err := k.blockBeforeSend(ctx, from, to, output.Coins); err != nil {
+				return err
+			}
+		}
+	}
+	return k.BaseKeeper.InputOutputCoins(ctx, inputs, outputs)
+}
+
+// SendCoinsFromModuleToAccount calls the hooks before sending the coins.
+func (k *BankKeeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, to sdk.AccAddress, amount sdk.Coins) error {
+	if err := k.blockBeforeSend(ctx, authtypes.NewModuleAddress(senderModule), to, amount); err != nil {
+		return err
+	}
+	return k.BaseKeeper.SendCoinsFromModuleToAccount(ctx, senderModule, to, amount)
+}
+
+// SendCoinsFromModuleToModule sends the coins without calling the hooks, as
+// done by the bank keeper of Osmosis. Modules move the coins they hold in
+// begin and end blockers, such as the fees allocated by distribution, which
+// would halt the chain if a hook rejected the send.
+func (k *BankKeeper) SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amount sdk.Coins) error {
+	return k.BaseKeeper.SendCoinsFromModuleToModule(ctx, senderModule, recipientModule, amount)
+}
+
+// SendCoinsFromAccountToModule calls the hooks before sending the coins.
+func (k *BankKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, from sdk.AccAddress, recipientModule string, amount sdk.Coins) error {
+	if err := k.blockBeforeSend(ctx, from, authtypes.NewModuleAddress(recipientModule), amount); err != nil {
+		return err
+	}
+	return k.BaseKeeper.SendCoinsFromAccountToModule(ctx, from, recipientModule, amount)
+}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	"github.com/oraichain/orai/x/tokenfactory/types"
+)
+
+// BlockBeforeSend calls the before send hook of each factory denom sent. The
+// send is rejected when a hook returns an error or runs out of gas.
+func (k Keeper) BlockBeforeSend(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	for _, coin := range amount {
+		if !types.IsFactoryDenom(coin.Denom) {
+			continue
+		}
+		contract := k.GetBeforeSendHook(ctx, coin.Denom)
+		if contract == "" {
+			continue
+		}
+
+		msg, err := json.Marshal(types.SudoMsg{
+			BlockBeforeSend: &types.BlockBeforeSend{
+				From:   from.String(),
+				To:     to.String(),
+				Amount: wasmvmtypes.Coin{Denom: coin.Denom, Amount: coin.Amount.String()},
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := k.sudo(ctx, sdk.MustAccAddressFromBech32(contract), msg); err != nil {
+			return sdkerrors.Wrapf(types.ErrSendBlocked, "%s by %s: %s", coin.Denom, contract, err)
+		}
+	}
+	return nil
+}
+
+func (k Keeper) sudo(ctx sdk.Context, contract sdk.AccAddress, msg []byte) (err error) {
+	gasLimit := k.GetParams(ctx).BeforeSendHookGasLimit
+	gasMeter := sdk.NewGasMeter(gasLimit)
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter)
+
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "hook exceeds gas limit %d", gasLimit)
+		}
+		ctx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), "tokenfactory before send hook")
+	}()
+
+	if _, err := k.contractKeeper.Sudo(cacheCtx, contract, msg); err != nil {
+		return err
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
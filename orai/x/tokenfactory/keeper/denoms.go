@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/oraichain/orai/x/tokenfactory/types"
+)
+
+// CreateDenom creates the denom factory/{creator}/{subdenom} with the creator
+// as admin, charging the creation fee to the community pool.
+func (k Keeper) CreateDenom(ctx sdk.Context, creator sdk.AccAddress, subdenom string) (string, error) {
+	denom, err := types.GetTokenDenom(creator.String(), subdenom)
+	if err != nil {
+		return "", err
+	}
+	if _, found := k.GetAuthorityMetadata(ctx, denom); found || k.bankKeeper.HasSupply(ctx, denom) {
+		return "", sdkerrors.Wrapf(types.ErrDenomExists, "denom %s", denom)
+	}
+
+	if fee := k.GetParams(ctx).DenomCreationFee; !fee.IsZero() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, fee, creator); err != nil {
+			return "", err
+		}
+	}
+
+	k.RegisterDenom(ctx, creator.String(), denom)
+	return denom, nil
+}
+
+// Mint mints an amount of a denom to an address. Only the admin of the denom
+// can mint.
+func (k Keeper) Mint(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coin, mintTo sdk.AccAddress) error {
+	if err := k.checkAdmin(ctx, sender, amount.Denom); err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mintTo, coins)
+}
+
+// Burn burns an amount of a denom from the balance of its admin.
+func (k Keeper) Burn(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coin) error {
+	if err := k.checkAdmin(ctx, sender, amount.Denom); err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, coins); err != nil {
+		return err
+	}
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
+}
+
+// ChangeAdmin sets the admin of a denom. An empty admin leaves the denom
+// without one, so that it can never be minted again.
+func (k Keeper) ChangeAdmin(ctx sdk.Context, sender sdk.AccAddress, denom, newAdmin string) error {
+	if err := k.checkAdmin(ctx, sender, denom); err != nil {
+		return err
+	}
+
+	k.SetAuthorityMetadata(ctx, denom, types.DenomAuthorityMetadata{Admin: newAdmin})
+	return nil
+}
+
+// SetDenomMetadata sets the bank metadata of a denom.
+func (k Keeper) SetDenomMetadata(ctx sdk.Context, sender sdk.AccAddress, metadata banktypes.Metadata) error {
+	if err := k.checkAdmin(ctx, sender, metadata.Base); err != nil {
+		return err
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+	return nil
+}
+
+// SetBeforeSendHook sets the contract called before each send of a denom. An
+// empty contract removes the hook.
+func (k Keeper) SetBeforeSendHook(ctx sdk.Context, sender sdk.AccAddress, denom, contract string) error {
+	if err := k.checkAdmin(ctx, sender, denom); err != nil {
+		return err
+	}
+
+	if contract != "" {
+		contractAddr, err := sdk.AccAddressFromBech32(contract)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address: %s", err)
+		}
+		if !k.contractKeeper.HasContractInfo(ctx, contractAddr) {
+			return sdkerrors.Wrapf(types.ErrInvalidHook, "%s is not a contract", contract)
+		}
+	}
+
+	k.StoreBeforeSendHook(ctx, denom, contract)
+	return nil
+}
+
+func (k Keeper) checkAdmin(ctx sdk.Context, sender sdk.AccAddress, denom string) error {
+	metadata, found := k.GetAuthorityMetadata(ctx, denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrDenomDoesNotExist, "denom %s", denom)
+	}
+	if metadata.Admin != sender.String() {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not the admin of %s", sender, denom)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/oraichain/orai/x/tokenfactory/types"
+)
+
+// Keeper of the tokenfactory module. It lets accounts and contracts create
+// their own native denoms and keeps the admin and the before send hook of
+// each of them.
+type Keeper struct {
+	storeKey   sdk.StoreKey
+	cdc        codec.BinaryCodec
+	paramSpace paramtypes.Subspace
+
+	accountKeeper  types.AccountKeeper
+	bankKeeper     types.BankKeeper
+	distrKeeper    types.DistrKeeper
+	contractKeeper types.ContractKeeper
+}
+
+func NewKeeper(
+	storeKey sdk.StoreKey,
+	cdc codec.BinaryCodec,
+	paramSpace paramtypes.Subspace,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	distrKeeper types.DistrKeeper,
+	contractKeeper types.ContractKeeper,
+) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:       storeKey,
+		cdc:            cdc,
+		paramSpace:     paramSpace,
+		accountKeeper:  accountKeeper,
+		bankKeeper:     bankKeeper,
+		distrKeeper:    distrKeeper,
+		contractKeeper: contractKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns the current x/tokenfactory module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (p types.Params) {
+	k.paramSpace.GetParamSet(ctx, &p)
+	return p
+}
+
+// SetParams sets the x/tokenfactory module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, p types.Params) {
+	k.paramSpace.SetParamSet(ctx, &p)
+}
+
+// GetAuthorityMetadata returns the authority metadata of a denom.
+func (k Keeper) GetAuthorityMetadata(ctx sdk.Context, denom string) (types.DenomAuthorityMetadata, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetDenomAuthorityKey(denom))
+	if bz == nil {
+		return types.DenomAuthorityMetadata{}, false
+	}
+
+	var metadata types.DenomAuthorityMetadata
+	k.cdc.MustUnmarshal(bz, &metadata)
+	return metadata, true
+}
+
+// SetAuthorityMetadata stores the authority metadata of a denom.
+func (k Keeper) SetAuthorityMetadata(ctx sdk.Context, denom string, metadata types.DenomAuthorityMetadata) {
+	ctx.KVStore(k.storeKey).Set(types.GetDenomAuthorityKey(denom), k.cdc.MustMarshal(&metadata))
+}
+
+// GetDenomsFromCreator returns the denoms created by an address.
+func (k Keeper) GetDenomsFromCreator(ctx sdk.Context, creator string) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetCreatorDenomsPrefix(creator))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	denoms := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		denoms = append(denoms, string(iterator.Key()))
+	}
+	return denoms
+}
+
+// IterateDenoms iterates over all the denoms created by the module.
+func (k Keeper) IterateDenoms(ctx sdk.Context, cb func(denom string, metadata types.DenomAuthorityMetadata) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomAuthorityPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var metadata types.DenomAuthorityMetadata
+		k.cdc.MustUnmarshal(iterator.Value(), &metadata)
+		if cb(string(iterator.Key()), metadata) {
+			break
+		}
+	}
+}
+
+// GetBeforeSendHook returns the before send hook contract of a denom, or an
+// empty string.
+func (k Keeper) GetBeforeSendHook(ctx sdk.Context, denom string) string {
+	return string(ctx.KVStore(k.storeKey).Get(types.GetBeforeSendHookKey(denom)))
+}
+
+// StoreBeforeSendHook stores the before send hook contract of a denom, without
+// checking that it is a contract.
+func (k Keeper) StoreBeforeSendHook(ctx sdk.Context, denom, contract string) {
+	store := ctx.KVStore(k.storeKey)
+	if contract == "" {
+		store.Delete(types.GetBeforeSendHookKey(denom))
+		return
+	}
+	store.Set(types.GetBeforeSendHookKey(denom), []byte(contract))
+}
+
+// RegisterDenom registers a denom with the creator as admin, without charging
+// the creation fee.
+func (k Keeper) RegisterDenom(ctx sdk.Context, creator, denom string) {
+	k.SetAuthorityMetadata(ctx, denom, types.DenomAuthorityMetadata{Admin: creator})
+	ctx.KVStore(k.storeKey).Set(types.GetCreatorDenomKey(creator, denom), []byte{})
+
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, denom); !found {
+		k.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+			Base:       denom,
+			Display:    denom,
+			DenomUnits: []*banktypes.DenomUnit{{Denom: denom}},
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/oraichain/orai/x/tokenfactory/types"
+)
+
+var _ types.QueryServer = &Querier{}
+
+type Querier struct {
+	keeper Keeper
+}
+
+func NewQuerier(k Keeper) Querier {
+	return Querier{
+		keeper: k,
+	}
+}
+
+// Params returns the tokenfactory parameters.
+func (q Querier) Params(stdCtx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+
+	return &types.QueryParamsResponse{
+		Params: q.keeper.GetParams(ctx),
+	}, nil
+}
+
+// DenomAuthorityMetadata returns the admin of a denom.
+func (q Querier) DenomAuthorityMetadata(stdCtx context.Context, req *types.QueryDenomAuthorityMetadataRequest) (*types.QueryDenomAuthorityMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+
+	metadata, found := q.keeper.GetAuthorityMetadata(ctx, req.Denom)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrDenomDoesNotExist, "denom %s", req.Denom)
+	}
+
+	return &types.QueryDenomAuthorityMetadataResponse{
+		AuthorityMetadata: metadata,
+	}, nil
+}
+
+// DenomsFromCreator returns the denoms created by an address.
+func (q Querier) DenomsFromCreator(stdCtx context.Context, req *types.QueryDenomsFromCreatorRequest) (*types.QueryDenomsFromCreatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+
+	return &types.QueryDenomsFromCreatorResponse{
+		Denoms: q.keeper.GetDenomsFromCreator(ctx, req.Creator),
+	}, nil
+}
+
+// BeforeSendHook returns the before send hook contract of a denom.
+func (q Querier) BeforeSendHook(stdCtx context.Context, req *types.QueryBeforeSendHookRequest) (*types.QueryBeforeSendHookResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+
+	return &types.QueryBeforeSendHookResponse{
+		Contract: q.keeper.GetBeforeSendHook(ctx, req.Denom),
+	}, nil
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// DenomPrefix starts the denoms created by the module
+	DenomPrefix = "factory"
+
+	// DenomSeparator separates the prefix, creator and subdenom of a denom
+	DenomSeparator = "/"
+
+	// MaxSubdenomLength bounds the subdenom so that the denoms of the longest
+	// addresses stay valid denoms
+	MaxSubdenomLength = 44
+)
+
+// GetTokenDenom returns the denom factory/{creator}/{subdenom}, after
+// checking that it is a valid denom.
+func GetTokenDenom(creator, subdenom string) (string, error) {
+	if len(subdenom) > MaxSubdenomLength {
+		return "", sdkerrors.Wrapf(ErrInvalidDenom, "subdenom is longer than %d characters", MaxSubdenomLength)
+	}
+	if strings.Contains(creator, DenomSeparator) {
+		return "", sdkerrors.Wrapf(ErrInvalidDenom, "creator %s contains %s", creator, DenomSeparator)
+	}
+
+	denom := strings.Join([]string{DenomPrefix, creator, subdenom}, DenomSeparator)
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", sdkerrors.Wrap(ErrInvalidDenom, err.Error())
+	}
+	return denom, nil
+}
+
+// DeconstructDenom returns the creator and subdenom of a denom created by the
+// module.
+func DeconstructDenom(denom string) (creator string, subdenom string, err error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", "", sdkerrors.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	parts := strings.SplitN(denom, DenomSeparator, 3)
+	if len(parts) != 3 || parts[0] != DenomPrefix {
+		return "", "", sdkerrors.Wrapf(ErrInvalidDenom, "denom %s is not of the form %s/{creator}/{subdenom}", denom, DenomPrefix)
+	}
+
+	creator = parts[1]
+	if _, err := sdk.AccAddressFromBech32(creator); err != nil {
+		return "", "", sdkerrors.Wrapf(ErrInvalidDenom, "invalid creator address %s: %s", creator, err)
+	}
+
+	// the subdenom may contain the separator
+	return creator, parts[2], nil
+}
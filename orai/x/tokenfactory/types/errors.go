@@ -0,0 +1,16 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/tokenfactory module sentinel errors
+var (
+	ErrDenomExists       = sdkerrors.Register(ModuleName, 2, "denom already exists")
+	ErrUnauthorized      = sdkerrors.Register(ModuleName, 3, "unauthorized account")
+	ErrInvalidDenom      = sdkerrors.Register(ModuleName, 4, "invalid denom")
+	ErrDenomDoesNotExist = sdkerrors.Register(ModuleName, 5, "denom does not exist")
+	ErrInvalidMetadata   = sdkerrors.Register(ModuleName, 6, "invalid denom metadata")
+	ErrInvalidHook       = sdkerrors.Register(ModuleName, 7, "invalid before send hook")
+	ErrSendBlocked       = sdkerrors.Register(ModuleName, 8, "send blocked by before send hook")
+)
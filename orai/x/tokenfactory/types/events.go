@@ -0,0 +1,20 @@
+package types
+
+// tokenfactory module event types
+const (
+	EventTypeCreateDenom       = "create_denom"
+	EventTypeMint              = "tf_mint"
+	EventTypeBurn              = "tf_burn"
+	EventTypeChangeAdmin       = "change_admin"
+	EventTypeSetDenomMetadata  = "set_denom_metadata"
+	EventTypeSetBeforeSendHook = "set_before_send_hook"
+
+	AttributeKeyCreator       = "creator"
+	AttributeKeyNewTokenDenom = "new_token_denom"
+	AttributeKeyAmount        = "amount"
+	AttributeKeyMintToAddress = "mint_to_address"
+	AttributeKeyBurnFrom      = "burn_from_address"
+	AttributeKeyDenom         = "denom"
+	AttributeKeyNewAdmin      = "new_admin"
+	AttributeKeyContract      = "contract"
+)
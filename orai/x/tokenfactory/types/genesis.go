@@ -0,0 +1,59 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, denoms []GenesisDenom) *GenesisState {
+	return &GenesisState{
+		Params:        params,
+		FactoryDenoms: denoms,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), []GenesisDenom{})
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := map[string]bool{}
+	for _, denom := range gs.FactoryDenoms {
+		if seen[denom.Denom] {
+			return fmt.Errorf("denom %s listed twice", denom.Denom)
+		}
+		seen[denom.Denom] = true
+
+		if _, _, err := DeconstructDenom(denom.Denom); err != nil {
+			return err
+		}
+		if err := denom.AuthorityMetadata.Validate(); err != nil {
+			return err
+		}
+		if denom.BeforeSendHook != "" {
+			if _, err := sdk.AccAddressFromBech32(denom.BeforeSendHook); err != nil {
+				return fmt.Errorf("invalid before send hook of denom %s: %w", denom.Denom, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Validate checks that the admin is empty or an address.
+func (m DenomAuthorityMetadata) Validate() error {
+	if m.Admin != "" {
+		if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+			return fmt.Errorf("invalid admin: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"strings"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "tokenfactory"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+var (
+	// DenomAuthorityPrefix prefixes the authority metadata of the denoms
+	DenomAuthorityPrefix = []byte{0x01}
+
+	// CreatorDenomPrefix prefixes the denoms of a creator
+	CreatorDenomPrefix = []byte{0x02}
+
+	// BeforeSendHookPrefix prefixes the before send hook contracts of the
+	// denoms
+	BeforeSendHookPrefix = []byte{0x03}
+)
+
+// GetDenomAuthorityKey returns the store key of the authority metadata of a
+// denom.
+func GetDenomAuthorityKey(denom string) []byte {
+	return append(DenomAuthorityPrefix, []byte(denom)...)
+}
+
+// GetCreatorDenomsPrefix returns the store prefix of the denoms of a creator.
+// The creator is followed by the separator, so that no creator prefixes
+// another.
+func GetCreatorDenomsPrefix(creator string) []byte {
+	return append(CreatorDenomPrefix, []byte(creator+DenomSeparator)...)
+}
+
+// GetCreatorDenomKey returns the store key marking a denom of a creator.
+func GetCreatorDenomKey(creator, denom string) []byte {
+	return append(GetCreatorDenomsPrefix(creator), []byte(denom)...)
+}
+
+// GetBeforeSendHookKey returns the store key of the before send hook contract
+// of a denom.
+func GetBeforeSendHookKey(denom string) []byte {
+	return append(BeforeSendHookPrefix, []byte(denom)...)
+}
+
+// IsFactoryDenom reports whether the denom is created by the module, without
+// validating it.
+func IsFactoryDenom(denom string) bool {
+	return strings.HasPrefix(denom, DenomPrefix+DenomSeparator)
+}
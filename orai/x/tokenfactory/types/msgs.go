@@ -0,0 +1,258 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	TypeMsgCreateDenom       = "create_denom"
+	TypeMsgMint              = "tf_mint"
+	TypeMsgBurn              = "tf_burn"
+	TypeMsgChangeAdmin       = "change_admin"
+	TypeMsgSetDenomMetadata  = "set_denom_metadata"
+	TypeMsgSetBeforeSendHook = "set_before_send_hook"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateDenom{}
+	_ sdk.Msg = &MsgMint{}
+	_ sdk.Msg = &MsgBurn{}
+	_ sdk.Msg = &MsgChangeAdmin{}
+	_ sdk.Msg = &MsgSetDenomMetadata{}
+	_ sdk.Msg = &MsgSetBeforeSendHook{}
+)
+
+// NewMsgCreateDenom creates a new MsgCreateDenom instance.
+func NewMsgCreateDenom(sender sdk.AccAddress, subdenom string) *MsgCreateDenom {
+	return &MsgCreateDenom{
+		Sender:   sender.String(),
+		Subdenom: subdenom,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgCreateDenom) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgCreateDenom) Type() string { return TypeMsgCreateDenom }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCreateDenom) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+	_, err := GetTokenDenom(msg.Sender, msg.Subdenom)
+	return err
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgCreateDenom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCreateDenom) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgMint creates a new MsgMint instance.
+func NewMsgMint(sender sdk.AccAddress, amount sdk.Coin, mintTo string) *MsgMint {
+	return &MsgMint{
+		Sender:        sender.String(),
+		Amount:        amount,
+		MintToAddress: mintTo,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgMint) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgMint) Type() string { return TypeMsgMint }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgMint) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+	if msg.MintToAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.MintToAddress); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid mint to address: %s", err)
+		}
+	}
+	return validateFactoryCoin(msg.Amount)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgMint) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgMint) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgBurn creates a new MsgBurn instance.
+func NewMsgBurn(sender sdk.AccAddress, amount sdk.Coin) *MsgBurn {
+	return &MsgBurn{
+		Sender: sender.String(),
+		Amount: amount,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgBurn) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgBurn) Type() string { return TypeMsgBurn }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgBurn) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+	return validateFactoryCoin(msg.Amount)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgBurn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgBurn) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgChangeAdmin creates a new MsgChangeAdmin instance.
+func NewMsgChangeAdmin(sender sdk.AccAddress, denom, newAdmin string) *MsgChangeAdmin {
+	return &MsgChangeAdmin{
+		Sender:   sender.String(),
+		Denom:    denom,
+		NewAdmin: newAdmin,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgChangeAdmin) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgChangeAdmin) Type() string { return TypeMsgChangeAdmin }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgChangeAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+	if msg.NewAdmin != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.NewAdmin); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid new admin address: %s", err)
+		}
+	}
+	_, _, err := DeconstructDenom(msg.Denom)
+	return err
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgChangeAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgChangeAdmin) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgSetDenomMetadata creates a new MsgSetDenomMetadata instance.
+func NewMsgSetDenomMetadata(sender sdk.AccAddress, metadata banktypes.Metadata) *MsgSetDenomMetadata {
+	return &MsgSetDenomMetadata{
+		Sender:   sender.String(),
+		Metadata: metadata,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgSetDenomMetadata) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgSetDenomMetadata) Type() string { return TypeMsgSetDenomMetadata }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetDenomMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+	if err := msg.Metadata.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidMetadata, err.Error())
+	}
+	_, _, err := DeconstructDenom(msg.Metadata.Base)
+	return err
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgSetDenomMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetDenomMetadata) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgSetBeforeSendHook creates a new MsgSetBeforeSendHook instance.
+func NewMsgSetBeforeSendHook(sender sdk.AccAddress, denom, contract string) *MsgSetBeforeSendHook {
+	return &MsgSetBeforeSendHook{
+		Sender:   sender.String(),
+		Denom:    denom,
+		Contract: contract,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgSetBeforeSendHook) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgSetBeforeSendHook) Type() string { return TypeMsgSetBeforeSendHook }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetBeforeSendHook) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+	if msg.Contract != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address: %s", err)
+		}
+	}
+	_, _, err := DeconstructDenom(msg.Denom)
+	return err
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgSetBeforeSendHook) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetBeforeSendHook) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// validateFactoryCoin checks that the coin is a positive amount of a denom of
+// the module.
+func validateFactoryCoin(coin sdk.Coin) error {
+	if !coin.IsValid() || coin.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount %s", coin)
+	}
+	_, _, err := DeconstructDenom(coin.Denom)
+	return err
+}
@@ -0,0 +1,89 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// DefaultBeforeSendHookGasLimit is the default gas of a before send hook.
+	DefaultBeforeSendHookGasLimit uint64 = 500_000
+
+	// DefaultDenomCreationFeeDenom is the denom of the default creation fee.
+	DefaultDenomCreationFeeDenom = "orai"
+)
+
+// DefaultDenomCreationFee is the default fee of creating a denom, 10 ORAI.
+var DefaultDenomCreationFee = sdk.NewCoins(sdk.NewInt64Coin(DefaultDenomCreationFeeDenom, 10_000_000))
+
+// Parameter store keys
+var (
+	KeyDenomCreationFee       = []byte("DenomCreationFee")
+	KeyBeforeSendHookGasLimit = []byte("BeforeSendHookGasLimit")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable the param key table for the tokenfactory module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(denomCreationFee sdk.Coins, beforeSendHookGasLimit uint64) Params {
+	return Params{
+		DenomCreationFee:       denomCreationFee,
+		BeforeSendHookGasLimit: beforeSendHookGasLimit,
+	}
+}
+
+// DefaultParams returns default parameters
+func DefaultParams() Params {
+	return NewParams(DefaultDenomCreationFee, DefaultBeforeSendHookGasLimit)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyDenomCreationFee, &p.DenomCreationFee, validateDenomCreationFee),
+		paramtypes.NewParamSetPair(KeyBeforeSendHookGasLimit, &p.BeforeSendHookGasLimit, validateBeforeSendHookGasLimit),
+	}
+}
+
+// Validate performs basic validation.
+func (p Params) Validate() error {
+	if err := validateDenomCreationFee(p.DenomCreationFee); err != nil {
+		return err
+	}
+	return validateBeforeSendHookGasLimit(p.BeforeSendHookGasLimit)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+func validateDenomCreationFee(i interface{}) error {
+	fee, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := fee.Validate(); err != nil {
+		return fmt.Errorf("invalid denom creation fee: %w", err)
+	}
+
+	return nil
+}
+
+func validateBeforeSendHookGasLimit(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
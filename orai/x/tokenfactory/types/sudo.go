@@ -0,0 +1,17 @@
+package types
+
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+)
+
+// SudoMsg is the msg a before send hook contract is called with. The send is
+// rejected when the contract returns an error.
+type SudoMsg struct {
+	BlockBeforeSend *BlockBeforeSend `json:"block_before_send,omitempty"`
+}
+
+type BlockBeforeSend struct {
+	From   string           `json:"from"`
+	To     string           `json:"to"`
+	Amount wasmvmtypes.Coin `json:"amount"`
+}